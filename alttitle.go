@@ -0,0 +1,157 @@
+package radarr
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// AlternativeTitle another name a movie is released under, e.g. its
+// original or a localized title
+type AlternativeTitle struct {
+	ID       int    `json:"id,omitempty"`
+	MovieID  int    `json:"movieId"`
+	Title    string `json:"title"`
+	Language string `json:"language"`
+	// SourceType where the title came from, e.g. tmdb, mappings, user or indexer
+	SourceType string `json:"sourceType"`
+	SourceID   int    `json:"sourceId"`
+	VoteCount  int    `json:"voteCount"`
+	Votes      int    `json:"votes"`
+}
+
+// GetAlternativeTitles returns the alternative titles of a movie
+// movieID is the id for the movie in the radarr library
+func (c Client) GetAlternativeTitles(movieID int) ([]AlternativeTitle, error) {
+	const endpoint = "/api/alttitle"
+
+	var titles []AlternativeTitle
+
+	params := url.Values{}
+
+	params.Set("movieId", strconv.Itoa(movieID))
+
+	resp, err := c.get(endpoint, params)
+
+	if err != nil {
+		return titles, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return titles, errors.New(resp.Status)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&titles)
+
+	return titles, err
+}
+
+// AddAlternativeTitle adds a title radarr should recognize releases of the
+// movie by and returns it with its new id
+func (c Client) AddAlternativeTitle(title AlternativeTitle) (AlternativeTitle, error) {
+	const endpoint = "/api/alttitle"
+
+	if title.MovieID == 0 {
+		return title, errors.New("movie id is required")
+	}
+
+	if title.Title == "" {
+		return title, errors.New("title is required")
+	}
+
+	if title.SourceType == "" {
+		title.SourceType = "user"
+	}
+
+	title.ID = 0
+
+	requestPayload, err := json.Marshal(title)
+
+	if err != nil {
+		return title, err
+	}
+
+	resp, err := c.post(endpoint, requestPayload)
+
+	if err != nil {
+		return title, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return title, errors.New(resp.Status)
+	}
+
+	var created AlternativeTitle
+
+	err = json.NewDecoder(resp.Body).Decode(&created)
+
+	return created, err
+}
+
+// DeleteAlternativeTitle removes an alternative title
+func (c Client) DeleteAlternativeTitle(id int) error {
+	const endpoint = "/api/alttitle/%d"
+
+	resp, err := c.delete(fmt.Sprintf(endpoint, id), nil)
+
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return errors.New(resp.Status)
+	}
+
+	return nil
+}
+
+// FindByAlternativeTitle returns the library movies whose title or any
+// alternative title contains title, ignoring case. A non-empty language,
+// e.g. german, only considers alternative titles in that language
+func (c Client) FindByAlternativeTitle(title, language string) ([]Movie, error) {
+	if title == "" {
+		return nil, errors.New("title is required")
+	}
+
+	movies, err := c.GetMovies(GetMovieOptions{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	query := strings.ToLower(title)
+
+	var found []Movie
+
+	for _, movie := range movies {
+		matched := language == "" && strings.Contains(strings.ToLower(movie.Title), query)
+
+		for _, alternative := range movie.AlternativeTitles {
+			if matched {
+				break
+			}
+
+			if language != "" && !strings.EqualFold(alternative.Language, language) {
+				continue
+			}
+
+			matched = strings.Contains(strings.ToLower(alternative.Title), query)
+		}
+
+		if matched {
+			found = append(found, movie)
+		}
+	}
+
+	return found, nil
+}
@@ -0,0 +1,139 @@
+package radarr
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAlternativeTitles(t *testing.T) {
+	var deleted []string
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/api/alttitle", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			var title AlternativeTitle
+
+			if err := json.NewDecoder(r.Body).Decode(&title); err != nil {
+				t.Error(err)
+			}
+
+			if title.ID != 0 || title.SourceType != "user" {
+				t.Errorf("expected a new user title, got %+v", title)
+			}
+
+			title.ID = 9
+
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(title)
+
+			return
+		}
+
+		if movieID := r.URL.Query().Get("movieId"); movieID != "1" {
+			t.Errorf("expected movieId 1, got %q", movieID)
+		}
+
+		w.Write([]byte(`[{"id":3,"movieId":1,"title":"Matrix","language":"english","sourceType":"tmdb","sourceId":603,"voteCount":0,"votes":0}]`))
+	})
+
+	mux.HandleFunc("/api/alttitle/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "DELETE" {
+			t.Errorf("expected a DELETE, got %s", r.Method)
+		}
+
+		deleted = append(deleted, r.URL.Path)
+
+		if r.URL.Path != "/api/alttitle/3" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	mux.HandleFunc("/api/movie", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[
+			{"id":1,"title":"The Matrix","alternativeTitles":[{"title":"Matrix","language":"english"}]},
+			{"id":2,"title":"Amélie","alternativeTitles":[{"title":"Die fabelhafte Welt der Amélie","language":"german"},{"title":"Le Fabuleux Destin d'Amélie Poulain","language":"french"}]},
+			{"id":3,"title":"Heat"}
+		]`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := New(server.URL, "abc123")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	titles, err := client.GetAlternativeTitles(1)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(titles) != 1 || titles[0].Title != "Matrix" || titles[0].SourceID != 603 {
+		t.Errorf("unexpected titles: %+v", titles)
+	}
+
+	created, err := client.AddAlternativeTitle(AlternativeTitle{ID: 4, MovieID: 1, Title: "Matrix 1999"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if created.ID != 9 || created.Title != "Matrix 1999" {
+		t.Errorf("unexpected title: %+v", created)
+	}
+
+	if _, err := client.AddAlternativeTitle(AlternativeTitle{Title: "Matrix"}); err == nil {
+		t.Error("expected a title without a movie id to return an error")
+	}
+
+	if err := client.DeleteAlternativeTitle(3); err != nil {
+		t.Error(err)
+	}
+
+	if err := client.DeleteAlternativeTitle(4); err == nil {
+		t.Error("expected deleting an unknown title to return an error")
+	}
+
+	if len(deleted) != 2 {
+		t.Errorf("expected 2 deletes, got %v", deleted)
+	}
+
+	tests := []struct {
+		title, language string
+		ids             []int
+	}{
+		{"matrix", "", []int{1}},
+		{"AMÉLIE", "", []int{2}},
+		{"amélie", "german", []int{2}},
+		{"Fabuleux", "german", nil},
+		{"heat", "english", nil},
+	}
+
+	for _, test := range tests {
+		movies, err := client.FindByAlternativeTitle(test.title, test.language)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var ids []int
+
+		for _, movie := range movies {
+			ids = append(ids, movie.ID)
+		}
+
+		if len(ids) != len(test.ids) || (len(ids) > 0 && ids[0] != test.ids[0]) {
+			t.Errorf("%q in %q: expected %v, got %v", test.title, test.language, test.ids, ids)
+		}
+	}
+
+	if _, err := client.FindByAlternativeTitle("", ""); err == nil {
+		t.Error("expected an empty title to return an error")
+	}
+}
@@ -0,0 +1,144 @@
+package radarr
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// BackupType how a backup was created
+type BackupType string
+
+const (
+	// BackupScheduled created by radarr's backup task
+	BackupScheduled BackupType = "scheduled"
+	// BackupManual created on request, e.g. via CreateBackup
+	BackupManual BackupType = "manual"
+	// BackupUpdate created before radarr installed an update
+	BackupUpdate BackupType = "update"
+)
+
+// Backup an archive of radarr's database and config
+type Backup struct {
+	ID   int        `json:"id"`
+	Name string     `json:"name"`
+	Path string     `json:"path"`
+	Type BackupType `json:"type"`
+	Time string     `json:"time"`
+}
+
+// GetBackups returns the backups radarr has kept on disk
+func (c Client) GetBackups() ([]Backup, error) {
+	const endpoint = "/api/system/backup"
+	var backups []Backup
+
+	resp, err := c.get(endpoint, nil)
+
+	if err != nil {
+		return backups, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return backups, errors.New(resp.Status)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&backups)
+
+	return backups, err
+}
+
+// CreateBackup queues a manual backup -- poll GetCommand with the returned
+// id to know when the archive is available through GetBackups
+func (c Client) CreateBackup() (Command, error) {
+	return c.RunCommand("Backup", nil)
+}
+
+// DownloadBackup streams the backup archive to w and returns the bytes written.
+// Large archives may need a longer Client.Timeout
+func (c Client) DownloadBackup(backup Backup, w io.Writer) (int64, error) {
+	if backup.Path == "" {
+		return 0, errors.New("backup path is required")
+	}
+
+	resp, err := c.get(backup.Path, nil)
+
+	if err != nil {
+		return 0, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, errors.New(resp.Status)
+	}
+
+	return io.Copy(w, resp.Body)
+}
+
+// DeleteBackup removes a backup archive from radarr's disk
+func (c Client) DeleteBackup(id int) error {
+	const endpoint = "/api/system/backup/%d"
+
+	resp, err := c.delete(fmt.Sprintf(endpoint, id), nil)
+
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return errors.New(resp.Status)
+	}
+
+	return nil
+}
+
+// RestoreBackup restores a backup radarr already has on disk. Radarr needs
+// to be restarted afterwards for the restore to take effect
+func (c Client) RestoreBackup(id int) error {
+	const endpoint = "/api/system/backup/restore/%d"
+
+	resp, err := c.post(fmt.Sprintf(endpoint, id), nil)
+
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return errors.New(resp.Status)
+	}
+
+	return nil
+}
+
+// UploadBackup uploads a backup archive, e.g. one saved by DownloadBackup,
+// and restores it. Radarr needs to be restarted afterwards for the restore
+// to take effect
+func (c Client) UploadBackup(fileName string, archive io.Reader) error {
+	const endpoint = "/api/system/backup/restore/upload"
+
+	if fileName == "" {
+		return errors.New("file name is required")
+	}
+
+	resp, err := c.upload(endpoint, "restore", fileName, archive)
+
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return errors.New(resp.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package radarr
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestBackups(t *testing.T) {
+	archive := strings.Repeat("radarr backup ", 1<<16)
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/api/system/backup", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"id":3,"name":"radarr_backup_2019.11.24.zip","path":"/backup/manual/radarr_backup_2019.11.24.zip","type":"manual","time":"2019-11-24T18:30:02Z"}]`))
+	})
+
+	mux.HandleFunc("/backup/manual/radarr_backup_2019.11.24.zip", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(archive))
+	})
+
+	mux.HandleFunc("/api/system/backup/restore/3", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("expected restore to POST, got %s", r.Method)
+		}
+	})
+
+	mux.HandleFunc("/api/system/backup/restore/4", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	mux.HandleFunc("/api/system/backup/3", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "DELETE" {
+			t.Errorf("expected delete to DELETE, got %s", r.Method)
+		}
+	})
+
+	mux.HandleFunc("/api/system/backup/restore/upload", func(w http.ResponseWriter, r *http.Request) {
+		file, header, err := r.FormFile("restore")
+
+		if err != nil {
+			t.Errorf("expected a multipart restore file: %v", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		defer file.Close()
+
+		uploaded, _ := io.ReadAll(file)
+
+		if header.Filename != "radarr_backup.zip" || string(uploaded) != archive {
+			t.Errorf("unexpected upload %s of %d bytes", header.Filename, len(uploaded))
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := New(server.URL, "abc123")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	backups, err := client.GetBackups()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(backups) != 1 || backups[0].ID != 3 || backups[0].Type != BackupManual {
+		t.Fatalf("unexpected backups: %+v", backups)
+	}
+
+	var downloaded bytes.Buffer
+
+	written, err := client.DownloadBackup(backups[0], &downloaded)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if written != int64(len(archive)) || downloaded.String() != archive {
+		t.Errorf("expected the whole archive to be downloaded, got %d bytes", written)
+	}
+
+	if err := client.RestoreBackup(3); err != nil {
+		t.Error(err)
+	}
+
+	if err := client.RestoreBackup(4); err == nil {
+		t.Error("expected restoring a missing backup to return an error")
+	}
+
+	if err := client.DeleteBackup(3); err != nil {
+		t.Error(err)
+	}
+
+	if err := client.UploadBackup("radarr_backup.zip", strings.NewReader(archive)); err != nil {
+		t.Error(err)
+	}
+
+	if err := client.UploadBackup("radarr_backup.zip", iotest.ErrReader(io.ErrUnexpectedEOF)); err == nil {
+		t.Error("expected a failing archive reader to fail the upload")
+	}
+}
@@ -0,0 +1,153 @@
+package radarr
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// BlocklistItem a release radarr won't grab again, usually because its
+// download failed
+type BlocklistItem struct {
+	ID          int    `json:"id"`
+	MovieID     int    `json:"movieId"`
+	SourceTitle string `json:"sourceTitle"`
+	Quality     struct {
+		Quality struct {
+			ID   int    `json:"id"`
+			Name string `json:"name"`
+		} `json:"quality"`
+	} `json:"quality"`
+	Date     string `json:"date"`
+	Protocol string `json:"protocol"`
+	Indexer  string `json:"indexer"`
+	Message  string `json:"message"`
+	Movie    Movie  `json:"movie"`
+}
+
+// Blocklist a page of blocklisted releases
+type Blocklist struct {
+	Page          int             `json:"page"`
+	PageSize      int             `json:"pageSize"`
+	SortKey       string          `json:"sortKey"`
+	SortDirection string          `json:"sortDirection"`
+	TotalRecords  int             `json:"totalRecords"`
+	Records       []BlocklistItem `json:"records"`
+}
+
+// BlocklistOptions change the params when using GetBlocklist
+type BlocklistOptions struct {
+	// Page defaults to 1
+	Page int
+	// PageSize defaults to 20
+	PageSize int
+	// SortKey can be 'date', 'sourceTitle' or 'movie.sortTitle' -- defaults to 'date'
+	SortKey string
+	// SortDir can be 'asc' or 'desc' -- defaults to 'desc'
+	SortDir string
+	// MovieID only returns the blocklisted releases of this movie when set
+	MovieID int
+}
+
+// GetBlocklist returns a page of blocklisted releases
+func (c Client) GetBlocklist(options BlocklistOptions) (Blocklist, error) {
+	const endpoint = "/api/blacklist"
+
+	var blocklist Blocklist
+
+	if options.Page == 0 {
+		options.Page = 1
+	}
+
+	if options.PageSize == 0 {
+		options.PageSize = 20
+	}
+
+	if options.SortKey == "" {
+		options.SortKey = "date"
+	}
+
+	if options.SortDir == "" {
+		options.SortDir = "desc"
+	}
+
+	params := url.Values{}
+
+	params.Set("page", strconv.Itoa(options.Page))
+	params.Set("pageSize", strconv.Itoa(options.PageSize))
+	params.Set("sortKey", options.SortKey)
+	params.Set("sortDir", options.SortDir)
+
+	if options.MovieID != 0 {
+		params.Set("movieId", strconv.Itoa(options.MovieID))
+	}
+
+	resp, err := c.get(endpoint, params)
+
+	if err != nil {
+		return blocklist, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return blocklist, errors.New(resp.Status)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&blocklist)
+
+	return blocklist, err
+}
+
+// DeleteBlocklistItem removes a release from the blocklist so it can be grabbed again
+func (c Client) DeleteBlocklistItem(id int) error {
+	const endpoint = "/api/blacklist/%d"
+
+	resp, err := c.delete(fmt.Sprintf(endpoint, id), nil)
+
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return errors.New(resp.Status)
+	}
+
+	return nil
+}
+
+// DeleteBlocklistItems removes several releases from the blocklist at once
+func (c Client) DeleteBlocklistItems(ids []int) error {
+	const endpoint = "/api/blacklist/bulk"
+
+	if len(ids) == 0 {
+		return errors.New("at least one id is required")
+	}
+
+	requestPayload, err := json.Marshal(struct {
+		IDs []int `json:"ids"`
+	}{ids})
+
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.deleteWithBody(endpoint, requestPayload)
+
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return errors.New(resp.Status)
+	}
+
+	return nil
+}
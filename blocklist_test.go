@@ -0,0 +1,85 @@
+package radarr
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestBlocklist(t *testing.T) {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/api/blacklist", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+
+		if query.Get("page") != "2" || query.Get("pageSize") != "20" || query.Get("sortKey") != "date" || query.Get("sortDir") != "desc" {
+			t.Errorf("expected page 2 with the default paging, got %s", r.URL.RawQuery)
+		}
+
+		if query.Get("movieId") != "12" || query.Has("filterKey") {
+			t.Errorf("expected a movie filter, got %s", r.URL.RawQuery)
+		}
+
+		w.Write([]byte(`{"page":2,"pageSize":20,"sortKey":"date","sortDirection":"descending","totalRecords":21,"records":[{"id":5,"movieId":12,"sourceTitle":"Heat.1995.1080p.BluRay.x264-GRP","quality":{"quality":{"id":7,"name":"Bluray-1080p"}},"date":"2019-11-24T18:30:02Z","protocol":"torrent","indexer":"Indexer","message":"Download failed"}]}`))
+	})
+
+	mux.HandleFunc("/api/blacklist/5", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "DELETE" {
+			t.Errorf("expected DELETE, got %s", r.Method)
+		}
+	})
+
+	mux.HandleFunc("/api/blacklist/6", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	mux.HandleFunc("/api/blacklist/bulk", func(w http.ResponseWriter, r *http.Request) {
+		var payload struct {
+			IDs []int `json:"ids"`
+		}
+
+		if r.Method != "DELETE" {
+			t.Errorf("expected DELETE, got %s", r.Method)
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil || len(payload.IDs) != 2 || payload.IDs[0] != 5 || payload.IDs[1] != 6 {
+			t.Errorf("unexpected bulk payload: %+v %v", payload, err)
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := New(server.URL, "abc123")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	blocklist, err := client.GetBlocklist(BlocklistOptions{Page: 2, MovieID: 12})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if blocklist.TotalRecords != 21 || len(blocklist.Records) != 1 || blocklist.Records[0].Quality.Quality.Name != "Bluray-1080p" {
+		t.Errorf("unexpected blocklist: %+v", blocklist)
+	}
+
+	if err := client.DeleteBlocklistItem(5); err != nil {
+		t.Error(err)
+	}
+
+	if err := client.DeleteBlocklistItem(6); err == nil {
+		t.Error("expected a non-200 status to return an error")
+	}
+
+	if err := client.DeleteBlocklistItems([]int{5, 6}); err != nil {
+		t.Error(err)
+	}
+
+	if err := client.DeleteBlocklistItems(nil); err == nil {
+		t.Error("expected an empty bulk removal to return an error")
+	}
+}
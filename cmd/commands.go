@@ -401,3 +401,57 @@ func addMovie(c *cli.Context) error {
 func deleteMovie(c *cli.Context) error {
 	return nil
 }
+
+func showLogs(c *cli.Context) error {
+	level := radarr.LogLevel(c.Args().First())
+
+	if level == "" {
+		level = radarr.LogError
+	}
+
+	// fire up store
+	db, err := startDB()
+
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	defer db.Close()
+
+	// grab credentials
+	radarrKey, err := db.getRadarrKey()
+
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	radarrURL, err := db.getRadarrURL()
+
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	// create radarr client to interface with radarr
+	client, err := radarr.New(radarrURL, radarrKey)
+
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	logs, err := client.GetLogs(radarr.LogOptions{Level: level})
+
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	// time [level] logger: message
+	for _, record := range logs.Records {
+		fmt.Printf("%s [%s] %s: %s\n", record.Time, record.Level, record.Logger, record.Message)
+
+		if record.Exception != "" {
+			fmt.Printf("\t%s\n", record.Exception)
+		}
+	}
+
+	return nil
+}
@@ -78,6 +78,11 @@ func main() {
 			Usage:  "display all the movies in your radarr library",
 			Action: showLibrary,
 		},
+		cli.Command{
+			Name:   "logs",
+			Usage:  "display recent log records at or above a level (default: error)",
+			Action: showLogs,
+		},
 	}
 
 	if err := app.Run(os.Args); err != nil {
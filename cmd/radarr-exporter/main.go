@@ -0,0 +1,38 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/jrudio/go-radarr-client"
+	"github.com/jrudio/go-radarr-client/exporter"
+)
+
+func main() {
+	radarrURL := flag.String("url", os.Getenv("RADARR_URL"), "url that points to radarr")
+	apiKey := flag.String("api-key", os.Getenv("RADARR_API_KEY"), "radarr api key")
+	listen := flag.String("listen", ":9707", "address to serve metrics on")
+	timeout := flag.Int("timeout", 10, "timeout in seconds for each call to radarr")
+
+	flag.Parse()
+
+	client, err := radarr.New(*radarrURL, *apiKey)
+
+	if err != nil {
+		fmt.Printf("radarr-exporter failed to start: %v\n", err)
+		os.Exit(1)
+	}
+
+	client.Timeout = *timeout
+
+	http.Handle("/metrics", exporter.New(client))
+
+	fmt.Printf("serving radarr metrics on %s/metrics\n", *listen)
+
+	if err := http.ListenAndServe(*listen, nil); err != nil {
+		fmt.Printf("radarr-exporter failed to run: %v\n", err)
+		os.Exit(1)
+	}
+}
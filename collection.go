@@ -0,0 +1,242 @@
+package radarr
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// CollectionMovie a movie that belongs to a collection, whether or not it is
+// in the library
+type CollectionMovie struct {
+	TmdbID     int    `json:"tmdbId"`
+	ImdbID     string `json:"imdbId"`
+	Title      string `json:"title"`
+	CleanTitle string `json:"cleanTitle"`
+	SortTitle  string `json:"sortTitle"`
+	Overview   string `json:"overview"`
+	Runtime    int    `json:"runtime"`
+	Year       int    `json:"year"`
+	Images     []struct {
+		CoverType string `json:"coverType"`
+		URL       string `json:"url"`
+	} `json:"images"`
+	Genres []string `json:"genres"`
+	Folder string   `json:"folder"`
+}
+
+// Collection a tmdb collection, e.g. a trilogy or franchise, and the
+// defaults radarr uses when adding its movies
+type Collection struct {
+	ID                  int               `json:"id"`
+	Title               string            `json:"title"`
+	SortTitle           string            `json:"sortTitle"`
+	TmdbID              int               `json:"tmdbId"`
+	Overview            string            `json:"overview"`
+	Monitored           bool              `json:"monitored"`
+	RootFolderPath      string            `json:"rootFolderPath"`
+	QualityProfileID    int               `json:"qualityProfileId"`
+	SearchOnAdd         bool              `json:"searchOnAdd"`
+	MinimumAvailability Availability      `json:"minimumAvailability"`
+	Movies              []CollectionMovie `json:"movies"`
+	Images              []struct {
+		CoverType string `json:"coverType"`
+		URL       string `json:"url"`
+	} `json:"images"`
+
+	Unknown
+}
+
+// UnmarshalJSON decodes the collection and keeps the fields it doesn't model in Extra
+func (c *Collection) UnmarshalJSON(data []byte) error {
+	type plain Collection
+
+	return unmarshalUnknown(data, (*plain)(c), &c.Unknown)
+}
+
+// MarshalJSON encodes the collection with Extra merged back in
+func (c Collection) MarshalJSON() ([]byte, error) {
+	type plain Collection
+
+	return marshalUnknown(plain(c), c.Unknown)
+}
+
+// AddCollectionOptions overrides the collection's defaults when using
+// AddMissingFromCollection -- zero values fall back to the collection
+type AddCollectionOptions struct {
+	QualityProfileID    int
+	RootFolderPath      string
+	MinimumAvailability Availability
+	// Monitored whether added movies are monitored -- defaults to whether
+	// the collection is monitored when nil
+	Monitored *bool
+	// SearchForMovie search for added movies when this or the collection's
+	// SearchOnAdd is set
+	SearchForMovie bool
+}
+
+// GetCollections returns every collection with its movies
+func (c Client) GetCollections() ([]Collection, error) {
+	const endpoint = "/api/collection"
+	var collections []Collection
+
+	resp, err := c.get(endpoint, nil)
+
+	if err != nil {
+		return collections, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return collections, errors.New(resp.Status)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&collections)
+
+	return collections, err
+}
+
+// GetCollection returns a collection by its id
+func (c Client) GetCollection(id int) (Collection, error) {
+	const endpoint = "/api/collection/%d"
+	var collection Collection
+
+	resp, err := c.get(fmt.Sprintf(endpoint, id), nil)
+
+	if err != nil {
+		return collection, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return collection, errors.New(resp.Status)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&collection)
+
+	return collection, err
+}
+
+// UpdateCollection saves a collection's monitoring and defaults
+func (c Client) UpdateCollection(collection Collection) (Collection, error) {
+	const endpoint = "/api/collection/%d"
+
+	if collection.ID == 0 {
+		return collection, errors.New("id is required")
+	}
+
+	if collection.Monitored && (collection.QualityProfileID == 0 || collection.RootFolderPath == "") {
+		return collection, errors.New("a quality profile id and root folder path are required to monitor a collection")
+	}
+
+	requestPayload, err := json.Marshal(collection)
+
+	if err != nil {
+		return collection, err
+	}
+
+	resp, err := c.put(fmt.Sprintf(endpoint, collection.ID), requestPayload)
+
+	if err != nil {
+		return collection, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusAccepted && resp.StatusCode != http.StatusOK {
+		return collection, errors.New(resp.Status)
+	}
+
+	var updated Collection
+
+	err = json.NewDecoder(resp.Body).Decode(&updated)
+
+	return updated, err
+}
+
+// AddMissingFromCollection adds the movies of a collection that aren't in
+// the library yet and returns the movies it added. Each movie is looked up
+// on tmdb and goes through AddMovie, so the same validation applies. Movies
+// that fail to be added don't stop the others, their errors are joined
+func (c Client) AddMissingFromCollection(collectionID int, options AddCollectionOptions) ([]Movie, error) {
+	collection, err := c.GetCollection(collectionID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	library, err := c.GetMovies(GetMovieOptions{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	inLibrary := make(map[int]bool, len(library))
+
+	for _, movie := range library {
+		inLibrary[movie.TmdbID] = true
+	}
+
+	if options.QualityProfileID == 0 {
+		options.QualityProfileID = collection.QualityProfileID
+	}
+
+	if options.RootFolderPath == "" {
+		options.RootFolderPath = collection.RootFolderPath
+	}
+
+	if options.MinimumAvailability == "" {
+		options.MinimumAvailability = collection.MinimumAvailability
+	}
+
+	monitored := collection.Monitored
+
+	if options.Monitored != nil {
+		monitored = *options.Monitored
+	}
+
+	var added []Movie
+	var errs []error
+
+	for _, member := range collection.Movies {
+		if inLibrary[member.TmdbID] {
+			continue
+		}
+
+		movie, err := c.GetMovie(member.TmdbID)
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s (%d): %w", member.Title, member.Year, err))
+			continue
+		}
+
+		movie.QualityProfileID = options.QualityProfileID
+		movie.RootFolderPath = options.RootFolderPath
+		movie.Monitored = monitored
+		movie.AddOptions.SearchForMovie = options.SearchForMovie || collection.SearchOnAdd
+
+		if options.MinimumAvailability != "" {
+			movie.MinimumAvailability = options.MinimumAvailability
+		}
+
+		if addErrs := c.AddMovie(movie); addErrs != nil {
+			for _, err := range addErrs {
+				// added by someone else in the meantime
+				if err == ErrorMovieExists {
+					continue
+				}
+
+				errs = append(errs, fmt.Errorf("%s (%d): %w", member.Title, member.Year, err))
+			}
+
+			continue
+		}
+
+		added = append(added, movie)
+	}
+
+	return added, errors.Join(errs...)
+}
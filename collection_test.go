@@ -0,0 +1,137 @@
+package radarr
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func TestCollections(t *testing.T) {
+	const collection = `{"id":1,"title":"The Matrix Collection","sortTitle":"matrix collection","tmdbId":2344,"monitored":false,"rootFolderPath":"/movies","qualityProfileId":4,"searchOnAdd":true,"minimumAvailability":"released","movies":[{"tmdbId":603,"title":"The Matrix","year":1999},{"tmdbId":604,"title":"The Matrix Reloaded","year":2003},{"tmdbId":605,"title":"The Matrix Revolutions","year":2003},{"tmdbId":624860,"title":"The Matrix Resurrections","year":2021}]}`
+
+	var added []Movie
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/api/collection", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[` + collection + `]`))
+	})
+
+	mux.HandleFunc("/api/collection/1", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PUT" {
+			var updated Collection
+
+			json.NewDecoder(r.Body).Decode(&updated)
+
+			if !updated.Monitored {
+				t.Errorf("expected the collection to be monitored, got %+v", updated)
+			}
+
+			w.WriteHeader(http.StatusAccepted)
+			json.NewEncoder(w).Encode(updated)
+
+			return
+		}
+
+		w.Write([]byte(collection))
+	})
+
+	mux.HandleFunc("/api/movie", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			var movie Movie
+
+			json.NewDecoder(r.Body).Decode(&movie)
+
+			added = append(added, movie)
+
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(movie)
+
+			return
+		}
+
+		w.Write([]byte(`[{"id":1,"title":"The Matrix","tmdbId":603}]`))
+	})
+
+	mux.HandleFunc("/api/movie/lookup/tmdb", func(w http.ResponseWriter, r *http.Request) {
+		tmdbID, _ := strconv.Atoi(r.URL.Query().Get("tmdbId"))
+
+		if tmdbID == 624860 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		fmt.Fprintf(w, `{"title":"Movie %d","titleSlug":"movie-%d","tmdbId":%d,"images":[{"coverType":"poster","url":"/poster.jpg"}]}`, tmdbID, tmdbID, tmdbID)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := New(server.URL, "abc123")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	collections, err := client.GetCollections()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(collections) != 1 || collections[0].TmdbID != 2344 || len(collections[0].Movies) != 4 || collections[0].MinimumAvailability != AvailabilityReleased {
+		t.Fatalf("unexpected collections: %+v", collections)
+	}
+
+	matrix, err := client.GetCollection(1)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	matrix.Monitored = true
+
+	if _, err := client.UpdateCollection(matrix); err != nil {
+		t.Error(err)
+	}
+
+	if _, err := client.UpdateCollection(Collection{ID: 1, Monitored: true}); err == nil {
+		t.Error("expected monitoring without a root folder and quality profile to return an error")
+	}
+
+	movies, err := client.AddMissingFromCollection(1, AddCollectionOptions{QualityProfileID: 7})
+
+	// Resurrections fails to be looked up, the others are still added
+	joined, ok := err.(interface{ Unwrap() []error })
+
+	if !ok || len(joined.Unwrap()) != 1 || errors.Unwrap(joined.Unwrap()[0]) == nil {
+		t.Errorf("expected the failing lookup to be returned wrapped, got %v", err)
+	}
+
+	if len(movies) != 2 || len(added) != 2 || added[0].TmdbID != 604 || added[1].TmdbID != 605 {
+		t.Fatalf("expected the two missing movies to be added, got %+v", added)
+	}
+
+	movie := added[0]
+
+	if movie.QualityProfileID != 7 || movie.RootFolderPath != "/movies" || movie.MinimumAvailability != AvailabilityReleased || !movie.AddOptions.SearchForMovie {
+		t.Errorf("expected the options to override the collection's defaults, got %+v", movie)
+	}
+
+	if movie.Monitored {
+		t.Error("expected the movies of an unmonitored collection to be added unmonitored")
+	}
+
+	added = nil
+	monitored := true
+
+	client.AddMissingFromCollection(1, AddCollectionOptions{Monitored: &monitored})
+
+	if len(added) != 2 || !added[0].Monitored || !added[1].Monitored {
+		t.Errorf("expected the caller's monitored option to be used, got %+v", added)
+	}
+}
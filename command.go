@@ -0,0 +1,88 @@
+package radarr
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Command a background task radarr runs, e.g. a backup or rss sync
+type Command struct {
+	ID                  int                    `json:"id"`
+	Name                string                 `json:"name"`
+	Body                map[string]interface{} `json:"body"`
+	Priority            string                 `json:"priority"`
+	Status              string                 `json:"status"`
+	Queued              string                 `json:"queued"`
+	Started             string                 `json:"started"`
+	Ended               string                 `json:"ended"`
+	Trigger             string                 `json:"trigger"`
+	State               string                 `json:"state"`
+	Manual              bool                   `json:"manual"`
+	StartedOn           string                 `json:"startedOn"`
+	StateChangeTime     string                 `json:"stateChangeTime"`
+	SendUpdatesToClient bool                   `json:"sendUpdatesToClient"`
+	UpdateScheduledTask bool                   `json:"updateScheduledTask"`
+}
+
+// RunCommand queues the command name in radarr, body holds any extra
+// arguments the command takes and can be nil
+func (c Client) RunCommand(name string, body map[string]interface{}) (Command, error) {
+	const endpoint = "/api/command"
+
+	var command Command
+
+	payload := map[string]interface{}{}
+
+	for key, value := range body {
+		payload[key] = value
+	}
+
+	payload["name"] = name
+
+	requestPayload, err := json.Marshal(payload)
+
+	if err != nil {
+		return command, err
+	}
+
+	resp, err := c.post(endpoint, requestPayload)
+
+	if err != nil {
+		return command, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return command, errors.New(resp.Status)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&command)
+
+	return command, err
+}
+
+// GetCommand returns the state of a queued command
+func (c Client) GetCommand(id int) (Command, error) {
+	const endpoint = "/api/command/%d"
+
+	var command Command
+
+	resp, err := c.get(fmt.Sprintf(endpoint, id), nil)
+
+	if err != nil {
+		return command, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return command, errors.New(resp.Status)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&command)
+
+	return command, err
+}
@@ -0,0 +1,154 @@
+package radarr
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// CreditType whether a person was in front of or behind the camera
+type CreditType string
+
+const (
+	// CreditCast actors
+	CreditCast CreditType = "cast"
+	// CreditCrew directors, writers, composers, etc.
+	CreditCrew CreditType = "crew"
+)
+
+// Credit a person's role in a movie
+type Credit struct {
+	ID           int    `json:"id"`
+	PersonName   string `json:"personName"`
+	CreditTmdbID string `json:"creditTmdbId"`
+	PersonTmdbID int    `json:"personTmdbId"`
+	MovieID      int    `json:"movieId"`
+	Images       []struct {
+		CoverType string `json:"coverType"`
+		URL       string `json:"url"`
+	} `json:"images"`
+	// Character played, only set for cast
+	Character string `json:"character"`
+	// Department e.g. Directing, only set for crew
+	Department string `json:"department"`
+	// Job e.g. Director, only set for crew
+	Job   string     `json:"job"`
+	Type  CreditType `json:"type"`
+	Order int        `json:"order"`
+}
+
+// MovieCredits a library movie and the credits of one person in it
+type MovieCredits struct {
+	Movie   Movie
+	Credits []Credit
+}
+
+// GetCredits returns the cast and crew of a movie
+// movieID is the id for the movie in the radarr library
+func (c Client) GetCredits(movieID int) ([]Credit, error) {
+	const endpoint = "/api/credit"
+
+	var credits []Credit
+
+	params := url.Values{}
+
+	params.Set("movieId", strconv.Itoa(movieID))
+
+	resp, err := c.get(endpoint, params)
+
+	if err != nil {
+		return credits, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return credits, errors.New(resp.Status)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&credits)
+
+	return credits, err
+}
+
+// GetCast returns the actors of a movie in billing order
+func (c Client) GetCast(movieID int) ([]Credit, error) {
+	return c.getCreditsOfType(movieID, CreditCast)
+}
+
+// GetCrew returns the crew of a movie
+func (c Client) GetCrew(movieID int) ([]Credit, error) {
+	return c.getCreditsOfType(movieID, CreditCrew)
+}
+
+func (c Client) getCreditsOfType(movieID int, creditType CreditType) ([]Credit, error) {
+	credits, err := c.GetCredits(movieID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var filtered []Credit
+
+	for _, credit := range credits {
+		if credit.Type == creditType {
+			filtered = append(filtered, credit)
+		}
+	}
+
+	return filtered, nil
+}
+
+// MoviesByPerson returns the library movies a person worked on along with
+// their credits in each, e.g. to find every movie by a director. Names are
+// compared case insensitively. This fetches the credits of every movie in
+// the library so it can take a while on large libraries. When some credits
+// can't be fetched the movies found in the rest are returned with the error
+func (c Client) MoviesByPerson(name string) ([]MovieCredits, error) {
+	if name == "" {
+		return nil, errors.New("name is required")
+	}
+
+	movies, err := c.GetMovies(GetMovieOptions{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*MovieCredits, len(movies))
+
+	err = eachMovie(movies, func(i int, movie Movie) error {
+		credits, err := c.GetCredits(movie.ID)
+
+		if err != nil {
+			return err
+		}
+
+		var matched []Credit
+
+		for _, credit := range credits {
+			if strings.EqualFold(credit.PersonName, name) {
+				matched = append(matched, credit)
+			}
+		}
+
+		if len(matched) > 0 {
+			results[i] = &MovieCredits{Movie: movie, Credits: matched}
+		}
+
+		return nil
+	})
+
+	var found []MovieCredits
+
+	for _, result := range results {
+		if result != nil {
+			found = append(found, *result)
+		}
+	}
+
+	return found, err
+}
@@ -0,0 +1,78 @@
+package radarr
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCredits(t *testing.T) {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/api/movie", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"id":1,"title":"Heat"},{"id":2,"title":"Collateral"},{"id":3,"title":"Alien"},{"id":4,"title":"Broken"}]`))
+	})
+
+	mux.HandleFunc("/api/credit", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("movieId") {
+		case "1":
+			w.Write([]byte(`[{"id":1,"personName":"Al Pacino","creditTmdbId":"52fe4","personTmdbId":1158,"movieId":1,"character":"Lt. Vincent Hanna","type":"cast","order":0},{"id":2,"personName":"Michael Mann","personTmdbId":638,"movieId":1,"department":"Directing","job":"Director","type":"crew","order":0},{"id":3,"personName":"Michael Mann","personTmdbId":638,"movieId":1,"department":"Writing","job":"Screenplay","type":"crew","order":0}]`))
+		case "2":
+			w.Write([]byte(`[{"id":4,"personName":"Michael Mann","personTmdbId":638,"movieId":2,"department":"Directing","job":"Director","type":"crew","order":0}]`))
+		case "3":
+			w.Write([]byte(`[{"id":5,"personName":"Ridley Scott","personTmdbId":578,"movieId":3,"department":"Directing","job":"Director","type":"crew","order":0}]`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := New(server.URL, "abc123")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	credits, err := client.GetCredits(1)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(credits) != 3 || credits[0].Character != "Lt. Vincent Hanna" || credits[1].Job != "Director" || credits[1].PersonTmdbID != 638 {
+		t.Errorf("unexpected credits: %+v", credits)
+	}
+
+	cast, err := client.GetCast(1)
+
+	if err != nil || len(cast) != 1 || cast[0].PersonName != "Al Pacino" {
+		t.Errorf("unexpected cast %+v: %v", cast, err)
+	}
+
+	crew, err := client.GetCrew(1)
+
+	if err != nil || len(crew) != 2 || crew[0].Type != CreditCrew {
+		t.Errorf("unexpected crew %+v: %v", crew, err)
+	}
+
+	if _, err := client.GetCredits(4); err == nil {
+		t.Error("expected a non-200 status to return an error")
+	}
+
+	// the credits of movie 4 fail, the movies before it are still returned
+	found, err := client.MoviesByPerson("michael mann")
+
+	if err == nil {
+		t.Error("expected the failing movie to return an error")
+	}
+
+	if len(found) != 2 || found[0].Movie.Title != "Heat" || len(found[0].Credits) != 2 || found[1].Movie.Title != "Collateral" {
+		t.Errorf("unexpected movies by person: %+v", found)
+	}
+
+	if _, err := client.MoviesByPerson(""); err == nil {
+		t.Error("expected an empty name to return an error")
+	}
+}
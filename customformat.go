@@ -0,0 +1,398 @@
+package radarr
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Field a setting of a radarr provider, e.g. the regex of a custom format
+// specification or the api key of an indexer
+type Field struct {
+	Order         int         `json:"order"`
+	Name          string      `json:"name"`
+	Label         string      `json:"label"`
+	Value         interface{} `json:"value"`
+	Type          string      `json:"type"`
+	Advanced      bool        `json:"advanced"`
+	HelpText      string      `json:"helpText,omitempty"`
+	SelectOptions []struct {
+		Value int    `json:"value"`
+		Name  string `json:"name"`
+		Order int    `json:"order"`
+	} `json:"selectOptions,omitempty"`
+}
+
+// CustomFormatSpecification a condition a release has to meet to match a custom format
+type CustomFormatSpecification struct {
+	Name               string  `json:"name"`
+	Implementation     string  `json:"implementation"`
+	ImplementationName string  `json:"implementationName,omitempty"`
+	InfoLink           string  `json:"infoLink,omitempty"`
+	Negate             bool    `json:"negate"`
+	Required           bool    `json:"required"`
+	Fields             []Field `json:"fields"`
+}
+
+// FieldValue returns the value of the field called name or nil
+func (s CustomFormatSpecification) FieldValue(name string) interface{} {
+	for _, field := range s.Fields {
+		if field.Name == name {
+			return field.Value
+		}
+	}
+
+	return nil
+}
+
+// CustomFormat tags releases matching its specifications, e.g. HDR or x265
+type CustomFormat struct {
+	ID                              int                         `json:"id,omitempty"`
+	Name                            string                      `json:"name"`
+	IncludeCustomFormatWhenRenaming bool                        `json:"includeCustomFormatWhenRenaming"`
+	Specifications                  []CustomFormatSpecification `json:"specifications"`
+
+	Unknown
+}
+
+// UnmarshalJSON decodes the custom format and keeps the fields it doesn't model in Extra
+func (f *CustomFormat) UnmarshalJSON(data []byte) error {
+	type plain CustomFormat
+
+	return unmarshalUnknown(data, (*plain)(f), &f.Unknown)
+}
+
+// MarshalJSON encodes the custom format with Extra merged back in
+func (f CustomFormat) MarshalJSON() ([]byte, error) {
+	type plain CustomFormat
+
+	return marshalUnknown(plain(f), f.Unknown)
+}
+
+// exportedCustomFormat the layout the radarr ui uses when importing and
+// exporting custom formats -- fields are an object keyed by name
+type exportedCustomFormat struct {
+	Name                            string                        `json:"name"`
+	IncludeCustomFormatWhenRenaming bool                          `json:"includeCustomFormatWhenRenaming"`
+	Specifications                  []exportedFormatSpecification `json:"specifications"`
+}
+
+type exportedFormatSpecification struct {
+	Name           string                 `json:"name"`
+	Implementation string                 `json:"implementation"`
+	Negate         bool                   `json:"negate"`
+	Required       bool                   `json:"required"`
+	Fields         map[string]interface{} `json:"fields"`
+}
+
+func (f CustomFormat) export() exportedCustomFormat {
+	exported := exportedCustomFormat{
+		Name:                            f.Name,
+		IncludeCustomFormatWhenRenaming: f.IncludeCustomFormatWhenRenaming,
+		Specifications:                  []exportedFormatSpecification{},
+	}
+
+	for _, spec := range f.Specifications {
+		fields := map[string]interface{}{}
+
+		for _, field := range spec.Fields {
+			fields[field.Name] = field.Value
+		}
+
+		exported.Specifications = append(exported.Specifications, exportedFormatSpecification{
+			Name:           spec.Name,
+			Implementation: spec.Implementation,
+			Negate:         spec.Negate,
+			Required:       spec.Required,
+			Fields:         fields,
+		})
+	}
+
+	return exported
+}
+
+func (f exportedCustomFormat) customFormat() CustomFormat {
+	format := CustomFormat{
+		Name:                            f.Name,
+		IncludeCustomFormatWhenRenaming: f.IncludeCustomFormatWhenRenaming,
+		Specifications:                  []CustomFormatSpecification{},
+	}
+
+	for _, spec := range f.Specifications {
+		var fields []Field
+
+		for name, value := range spec.Fields {
+			fields = append(fields, Field{Name: name, Value: value})
+		}
+
+		sort.Slice(fields, func(i, j int) bool {
+			return fields[i].Name < fields[j].Name
+		})
+
+		format.Specifications = append(format.Specifications, CustomFormatSpecification{
+			Name:           spec.Name,
+			Implementation: spec.Implementation,
+			Negate:         spec.Negate,
+			Required:       spec.Required,
+			Fields:         fields,
+		})
+	}
+
+	return format
+}
+
+// GetCustomFormats returns every custom format
+func (c Client) GetCustomFormats() ([]CustomFormat, error) {
+	const endpoint = "/api/customformat"
+	var formats []CustomFormat
+
+	resp, err := c.get(endpoint, nil)
+
+	if err != nil {
+		return formats, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return formats, errors.New(resp.Status)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&formats)
+
+	return formats, err
+}
+
+// GetCustomFormat returns a custom format by its id
+func (c Client) GetCustomFormat(id int) (CustomFormat, error) {
+	const endpoint = "/api/customformat/%d"
+	var format CustomFormat
+
+	resp, err := c.get(fmt.Sprintf(endpoint, id), nil)
+
+	if err != nil {
+		return format, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return format, errors.New(resp.Status)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&format)
+
+	return format, err
+}
+
+// CreateCustomFormat adds a custom format and returns it with its new id
+func (c Client) CreateCustomFormat(format CustomFormat) (CustomFormat, error) {
+	const endpoint = "/api/customformat"
+
+	if format.Name == "" {
+		return format, errors.New("name is required")
+	}
+
+	format.ID = 0
+
+	requestPayload, err := json.Marshal(format)
+
+	if err != nil {
+		return format, err
+	}
+
+	resp, err := c.post(endpoint, requestPayload)
+
+	if err != nil {
+		return format, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return format, errors.New(resp.Status)
+	}
+
+	var created CustomFormat
+
+	err = json.NewDecoder(resp.Body).Decode(&created)
+
+	return created, err
+}
+
+// UpdateCustomFormat replaces the custom format with the same id
+func (c Client) UpdateCustomFormat(format CustomFormat) (CustomFormat, error) {
+	const endpoint = "/api/customformat/%d"
+
+	if format.ID == 0 {
+		return format, errors.New("id is required")
+	}
+
+	if format.Name == "" {
+		return format, errors.New("name is required")
+	}
+
+	requestPayload, err := json.Marshal(format)
+
+	if err != nil {
+		return format, err
+	}
+
+	resp, err := c.put(fmt.Sprintf(endpoint, format.ID), requestPayload)
+
+	if err != nil {
+		return format, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusAccepted && resp.StatusCode != http.StatusOK {
+		return format, errors.New(resp.Status)
+	}
+
+	var updated CustomFormat
+
+	err = json.NewDecoder(resp.Body).Decode(&updated)
+
+	return updated, err
+}
+
+// DeleteCustomFormat removes a custom format
+func (c Client) DeleteCustomFormat(id int) error {
+	const endpoint = "/api/customformat/%d"
+
+	resp, err := c.delete(fmt.Sprintf(endpoint, id), nil)
+
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return errors.New(resp.Status)
+	}
+
+	return nil
+}
+
+// ExportCustomFormats writes every custom format to w as a json array in the
+// layout the radarr ui uses, so the output can be pasted into its import dialog
+func (c Client) ExportCustomFormats(w io.Writer) error {
+	formats, err := c.GetCustomFormats()
+
+	if err != nil {
+		return err
+	}
+
+	exported := make([]exportedCustomFormat, 0, len(formats))
+
+	for _, format := range formats {
+		exported = append(exported, format.export())
+	}
+
+	encoder := json.NewEncoder(w)
+
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(exported)
+}
+
+// ImportCustomFormats reads custom formats in the radarr ui layout -- either
+// a single format or an array -- and creates them, or updates the existing
+// format with the same name. Formats identical to the existing one are left
+// alone, so importing the same file twice changes nothing
+func (c Client) ImportCustomFormats(r io.Reader) ([]CustomFormat, error) {
+	data, err := io.ReadAll(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var imported []exportedCustomFormat
+
+	if trimmed := strings.TrimSpace(string(data)); strings.HasPrefix(trimmed, "{") {
+		var single exportedCustomFormat
+
+		if err := json.Unmarshal(data, &single); err != nil {
+			return nil, err
+		}
+
+		imported = append(imported, single)
+	} else if err := json.Unmarshal(data, &imported); err != nil {
+		return nil, err
+	}
+
+	existing, err := c.GetCustomFormats()
+
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]CustomFormat, len(existing))
+
+	for _, format := range existing {
+		byName[format.Name] = format
+	}
+
+	var results []CustomFormat
+
+	for _, format := range imported {
+		current, ok := byName[format.Name]
+
+		if !ok {
+			created, err := c.CreateCustomFormat(format.customFormat())
+
+			if err != nil {
+				return results, fmt.Errorf("create custom format %q: %v", format.Name, err)
+			}
+
+			byName[format.Name] = created
+			results = append(results, created)
+			continue
+		}
+
+		if reflect.DeepEqual(normalizeFormat(current.export()), normalizeFormat(format)) {
+			results = append(results, current)
+			continue
+		}
+
+		update := format.customFormat()
+		update.ID = current.ID
+		update.Unknown = current.Unknown
+
+		updated, err := c.UpdateCustomFormat(update)
+
+		if err != nil {
+			return results, fmt.Errorf("update custom format %q: %v", format.Name, err)
+		}
+
+		byName[format.Name] = updated
+		results = append(results, updated)
+	}
+
+	return results, nil
+}
+
+// normalizeFormat round trips a format through json so values decoded from
+// different sources, e.g. int vs float64, compare equal
+func normalizeFormat(format exportedCustomFormat) interface{} {
+	var normalized interface{}
+
+	data, err := json.Marshal(format)
+
+	if err != nil {
+		return format
+	}
+
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return format
+	}
+
+	return normalized
+}
@@ -0,0 +1,152 @@
+package radarr
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// newCustomFormatServer stands in for radarr's custom format endpoints and
+// counts the writes made against it
+func newCustomFormatServer(t *testing.T, formats []CustomFormat) (*httptest.Server, *int) {
+	writes := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			json.NewEncoder(w).Encode(formats)
+		case "POST":
+			var format CustomFormat
+
+			json.NewDecoder(r.Body).Decode(&format)
+
+			format.ID = len(formats) + 1
+			formats = append(formats, format)
+			writes++
+
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(format)
+		case "PUT":
+			var format CustomFormat
+
+			json.NewDecoder(r.Body).Decode(&format)
+
+			if !strings.HasSuffix(r.URL.Path, "/"+strconv.Itoa(format.ID)) {
+				t.Errorf("expected the url to end with the format id, got %s", r.URL.Path)
+			}
+
+			for i := range formats {
+				if formats[i].ID == format.ID {
+					formats[i] = format
+				}
+			}
+
+			writes++
+
+			w.WriteHeader(http.StatusAccepted)
+			json.NewEncoder(w).Encode(format)
+		}
+	}))
+
+	return server, &writes
+}
+
+func TestImportCustomFormats(t *testing.T) {
+	const exported = `[
+		{
+			"name": "x265",
+			"includeCustomFormatWhenRenaming": false,
+			"specifications": [
+				{"name": "x265", "implementation": "ReleaseTitleSpecification", "negate": false, "required": true, "fields": {"value": "[xh][ ._-]?265|\\bHEVC(\\b|\\d)"}}
+			]
+		},
+		{
+			"name": "HDR",
+			"includeCustomFormatWhenRenaming": true,
+			"specifications": [
+				{"name": "HDR", "implementation": "ReleaseTitleSpecification", "negate": false, "required": false, "fields": {"value": "\\bHDR(\\b|\\d)"}},
+				{"name": "Not SDR", "implementation": "ReleaseTitleSpecification", "negate": true, "required": false, "fields": {"value": "\\bSDR\\b"}}
+			]
+		}
+	]`
+
+	existing := []CustomFormat{
+		{
+			ID:   1,
+			Name: "x265",
+			Specifications: []CustomFormatSpecification{
+				{Name: "x265", Implementation: "ReleaseTitleSpecification", Fields: []Field{{Name: "value", Value: "x265"}}},
+			},
+		},
+	}
+
+	server, writes := newCustomFormatServer(t, existing)
+	defer server.Close()
+
+	client, err := New(server.URL, "abc123")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := client.ImportCustomFormats(strings.NewReader(exported))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if *writes != 2 {
+		t.Errorf("expected one update and one create, got %d writes", *writes)
+	}
+
+	if len(results) != 2 || results[0].ID != 1 || results[1].ID != 2 {
+		t.Errorf("expected x265 to keep id 1 and HDR to be created with id 2, got %+v", results)
+	}
+
+	if _, err := client.ImportCustomFormats(strings.NewReader(exported)); err != nil {
+		t.Fatal(err)
+	}
+
+	if *writes != 2 {
+		t.Errorf("expected importing the same formats twice to be a no-op, got %d writes", *writes)
+	}
+
+	// the export should be importable as-is
+	var buf bytes.Buffer
+
+	if err := client.ExportCustomFormats(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.ImportCustomFormats(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	if *writes != 2 {
+		t.Errorf("expected re-importing an export to be a no-op, got %d writes", *writes)
+	}
+	// a name repeated within one import is created once and then updated
+	const repeated = `[
+		{"name": "DV", "includeCustomFormatWhenRenaming": false, "specifications": [{"name": "DV", "implementation": "ReleaseTitleSpecification", "negate": false, "required": true, "fields": {"value": "\\bDV\\b"}}]},
+		{"name": "DV", "includeCustomFormatWhenRenaming": false, "specifications": [{"name": "DV", "implementation": "ReleaseTitleSpecification", "negate": false, "required": true, "fields": {"value": "\\b(DV|DoVi)\\b"}}]},
+		{"name": "DV", "includeCustomFormatWhenRenaming": false, "specifications": [{"name": "DV", "implementation": "ReleaseTitleSpecification", "negate": false, "required": true, "fields": {"value": "\\b(DV|DoVi)\\b"}}]}
+	]`
+
+	results, err = client.ImportCustomFormats(strings.NewReader(repeated))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if *writes != 4 {
+		t.Errorf("expected one create and one update for the repeated name, got %d writes", *writes-2)
+	}
+
+	if len(results) != 3 || results[0].ID != 3 || results[1].ID != 3 || results[2].ID != 3 {
+		t.Errorf("expected every DV to be the same format, got %+v", results)
+	}
+}
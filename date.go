@@ -0,0 +1,83 @@
+package radarr
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// dateLayouts the formats radarr writes dates in, depending on the version
+// and whether the date has a time
+var dateLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.9999999",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// Date a date from radarr. Empty and null dates decode to the zero time and
+// the zero time is written as an empty string, the way radarr sends dates
+// that aren't set
+type Date struct {
+	time.Time
+}
+
+// NewDate wraps t as a Date
+func NewDate(t time.Time) Date {
+	return Date{Time: t}
+}
+
+// ParseDate parses a date in any of the formats radarr uses
+func ParseDate(value string) (Date, error) {
+	if value == "" {
+		return Date{}, nil
+	}
+
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return Date{Time: t}, nil
+		}
+	}
+
+	return Date{}, fmt.Errorf("unknown date format: %q", value)
+}
+
+// String the date in RFC 3339 format, or an empty string when it isn't set
+func (d Date) String() string {
+	if d.IsZero() {
+		return ""
+	}
+
+	return d.Format(time.RFC3339Nano)
+}
+
+// UnmarshalJSON accepts a date string in any of radarr's formats, an empty
+// string or null
+func (d *Date) UnmarshalJSON(data []byte) error {
+	var value *string
+
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	if value == nil {
+		*d = Date{}
+		return nil
+	}
+
+	parsed, err := ParseDate(*value)
+
+	if err != nil {
+		return err
+	}
+
+	*d = parsed
+
+	return nil
+}
+
+// MarshalJSON writes the date in RFC 3339 format, or an empty string when it
+// isn't set
+func (d Date) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
@@ -0,0 +1,47 @@
+package radarr
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestDate(t *testing.T) {
+	encoded, err := json.Marshal(Date{})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// radarr sends dates that aren't set as empty strings, and AddMovie always did
+	if string(encoded) != `""` {
+		t.Errorf("expected the zero date to be written as an empty string, got %s", encoded)
+	}
+
+	encoded, _ = json.Marshal(NewDate(time.Date(2018, 5, 1, 12, 30, 0, 0, time.UTC)))
+
+	if string(encoded) != `"2018-05-01T12:30:00Z"` {
+		t.Errorf("expected a date to be written in rfc 3339, got %s", encoded)
+	}
+
+	for _, value := range []string{`"2018-05-01T12:30:00Z"`, `"2018-05-01T12:30:00"`, `"2018-05-01 12:30:00"`} {
+		var date Date
+
+		if err := json.Unmarshal([]byte(value), &date); err != nil {
+			t.Fatal(err)
+		}
+
+		// dates are comparable and can be used as map keys
+		if date != NewDate(time.Date(2018, 5, 1, 12, 30, 0, 0, time.UTC)) {
+			t.Errorf("unexpected date for %s: %v", value, date)
+		}
+
+		if seen := map[Date]bool{date: true}; !seen[NewDate(time.Date(2018, 5, 1, 12, 30, 0, 0, time.UTC))] {
+			t.Errorf("expected %s to be found as a map key", value)
+		}
+	}
+
+	if date, err := ParseDate(""); err != nil || date != (Date{}) {
+		t.Errorf("expected an empty string to parse to the zero date, got %v %v", date, err)
+	}
+}
@@ -0,0 +1,300 @@
+package radarr
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+)
+
+// Protocol how a release is downloaded
+type Protocol string
+
+const (
+	// ProtocolUsenet nzb releases
+	ProtocolUsenet Protocol = "usenet"
+	// ProtocolTorrent torrent releases
+	ProtocolTorrent Protocol = "torrent"
+)
+
+// defaultDelayProfileOrder radarr always sorts the default delay profile last
+const defaultDelayProfileOrder = 2147483647
+
+// DelayProfile how long radarr waits for a better release before grabbing,
+// for movies with any of the tags -- the profile without tags applies to
+// every other movie
+type DelayProfile struct {
+	ID                int      `json:"id,omitempty"`
+	EnableUsenet      bool     `json:"enableUsenet"`
+	EnableTorrent     bool     `json:"enableTorrent"`
+	PreferredProtocol Protocol `json:"preferredProtocol"`
+	// UsenetDelay in minutes
+	UsenetDelay int `json:"usenetDelay"`
+	// TorrentDelay in minutes
+	TorrentDelay int   `json:"torrentDelay"`
+	Order        int   `json:"order"`
+	Tags         []int `json:"tags"`
+
+	Unknown
+}
+
+// UnmarshalJSON decodes the delay profile and keeps the fields it doesn't model in Extra
+func (p *DelayProfile) UnmarshalJSON(data []byte) error {
+	type plain DelayProfile
+
+	return unmarshalUnknown(data, (*plain)(p), &p.Unknown)
+}
+
+// MarshalJSON encodes the delay profile with Extra merged back in
+func (p DelayProfile) MarshalJSON() ([]byte, error) {
+	type plain DelayProfile
+
+	return marshalUnknown(plain(p), p.Unknown)
+}
+
+// IsDefault the profile applies to movies no other profile matches
+func (p DelayProfile) IsDefault() bool {
+	return p.Order == defaultDelayProfileOrder
+}
+
+func (p DelayProfile) validate() error {
+	if !p.EnableUsenet && !p.EnableTorrent {
+		return errors.New("either usenet or torrent needs to be enabled")
+	}
+
+	switch p.PreferredProtocol {
+	case ProtocolUsenet, ProtocolTorrent:
+	default:
+		return fmt.Errorf("unknown preferred protocol: %q", p.PreferredProtocol)
+	}
+
+	if p.UsenetDelay < 0 || p.TorrentDelay < 0 {
+		return errors.New("delays can't be negative")
+	}
+
+	if !p.IsDefault() && len(p.Tags) == 0 {
+		return errors.New("at least one tag is required")
+	}
+
+	return nil
+}
+
+// GetDelayProfiles returns every delay profile in the order radarr applies them
+func (c Client) GetDelayProfiles() ([]DelayProfile, error) {
+	const endpoint = "/api/delayprofile"
+	var profiles []DelayProfile
+
+	resp, err := c.get(endpoint, nil)
+
+	if err != nil {
+		return profiles, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return profiles, errors.New(resp.Status)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&profiles); err != nil {
+		return profiles, err
+	}
+
+	sort.SliceStable(profiles, func(i, j int) bool {
+		return profiles[i].Order < profiles[j].Order
+	})
+
+	return profiles, nil
+}
+
+// CreateDelayProfile adds a delay profile and returns it with its new id
+func (c Client) CreateDelayProfile(profile DelayProfile) (DelayProfile, error) {
+	const endpoint = "/api/delayprofile"
+
+	if err := profile.validate(); err != nil {
+		return profile, err
+	}
+
+	profile.ID = 0
+
+	requestPayload, err := json.Marshal(profile)
+
+	if err != nil {
+		return profile, err
+	}
+
+	resp, err := c.post(endpoint, requestPayload)
+
+	if err != nil {
+		return profile, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return profile, errors.New(resp.Status)
+	}
+
+	var created DelayProfile
+
+	err = json.NewDecoder(resp.Body).Decode(&created)
+
+	return created, err
+}
+
+// UpdateDelayProfile replaces the delay profile with the same id
+func (c Client) UpdateDelayProfile(profile DelayProfile) (DelayProfile, error) {
+	const endpoint = "/api/delayprofile/%d"
+
+	if profile.ID == 0 {
+		return profile, errors.New("id is required")
+	}
+
+	if err := profile.validate(); err != nil {
+		return profile, err
+	}
+
+	requestPayload, err := json.Marshal(profile)
+
+	if err != nil {
+		return profile, err
+	}
+
+	resp, err := c.put(fmt.Sprintf(endpoint, profile.ID), requestPayload)
+
+	if err != nil {
+		return profile, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusAccepted && resp.StatusCode != http.StatusOK {
+		return profile, errors.New(resp.Status)
+	}
+
+	var updated DelayProfile
+
+	err = json.NewDecoder(resp.Body).Decode(&updated)
+
+	return updated, err
+}
+
+// DeleteDelayProfile removes a delay profile -- the default profile can't be deleted
+func (c Client) DeleteDelayProfile(id int) error {
+	const endpoint = "/api/delayprofile/%d"
+
+	resp, err := c.delete(fmt.Sprintf(endpoint, id), nil)
+
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return errors.New(resp.Status)
+	}
+
+	return nil
+}
+
+// ReorderDelayProfiles changes the order radarr applies delay profiles in.
+// ids lists the tagged profiles from first to last, the default profile
+// always stays last. Profiles missing from ids keep their relative order
+// after the listed ones. Radarr has no endpoint to reorder at once, so each
+// moved profile is updated on its own. If an update fails the profiles
+// already moved are put back, and the error says whether that worked
+func (c Client) ReorderDelayProfiles(ids []int) ([]DelayProfile, error) {
+	profiles, err := c.GetDelayProfiles()
+
+	if err != nil {
+		return profiles, err
+	}
+
+	byID := make(map[int]DelayProfile, len(profiles))
+
+	for _, profile := range profiles {
+		if !profile.IsDefault() {
+			byID[profile.ID] = profile
+		}
+	}
+
+	listed := make(map[int]bool, len(ids))
+	var ordered []DelayProfile
+
+	for _, id := range ids {
+		if listed[id] {
+			return profiles, fmt.Errorf("delay profile %d is listed twice", id)
+		}
+
+		profile, ok := byID[id]
+
+		if !ok {
+			return profiles, fmt.Errorf("delay profile %d doesn't exist or is the default profile", id)
+		}
+
+		listed[id] = true
+		ordered = append(ordered, profile)
+	}
+
+	var defaultProfile *DelayProfile
+
+	for i, profile := range profiles {
+		switch {
+		case profile.IsDefault():
+			defaultProfile = &profiles[i]
+		case !listed[profile.ID]:
+			ordered = append(ordered, profile)
+		}
+	}
+
+	var results, moved []DelayProfile
+
+	for i, profile := range ordered {
+		if profile.Order == i+1 {
+			results = append(results, profile)
+			continue
+		}
+
+		previous := profile
+		profile.Order = i + 1
+
+		updated, err := c.UpdateDelayProfile(profile)
+
+		if err != nil {
+			return nil, c.undoReorder(moved, len(ordered), err)
+		}
+
+		moved = append(moved, previous)
+		results = append(results, updated)
+	}
+
+	if defaultProfile != nil {
+		results = append(results, *defaultProfile)
+	}
+
+	return results, nil
+}
+
+// undoReorder puts back the profiles a failed reorder already moved
+func (c Client) undoReorder(moved []DelayProfile, total int, cause error) error {
+	if len(moved) == 0 {
+		return fmt.Errorf("reordering delay profiles failed, nothing was changed: %w", cause)
+	}
+
+	var errs []error
+
+	for _, profile := range moved {
+		if _, err := c.UpdateDelayProfile(profile); err != nil {
+			errs = append(errs, fmt.Errorf("restoring delay profile %d: %w", profile.ID, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("reordering delay profiles failed after moving %d of %d and they couldn't all be put back, the order is partially changed: %w",
+			len(moved), total, errors.Join(append([]error{cause}, errs...)...))
+	}
+
+	return fmt.Errorf("reordering delay profiles failed, the previous order was restored: %w", cause)
+}
@@ -0,0 +1,228 @@
+package radarr
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// delayProfileServer an in-memory /api/delayprofile and /api/tag
+type delayProfileServer struct {
+	mu       sync.Mutex
+	profiles map[int]DelayProfile
+	tags     []Tag
+	// failPut makes updates of this profile fail
+	failPut int
+}
+
+func (s *delayProfileServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r.URL.Path == "/api/tag" {
+		if r.Method == "POST" {
+			var tag Tag
+
+			json.NewDecoder(r.Body).Decode(&tag)
+
+			tag.ID = len(s.tags) + 1
+			s.tags = append(s.tags, tag)
+
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(tag)
+
+			return
+		}
+
+		json.NewEncoder(w).Encode(s.tags)
+
+		return
+	}
+
+	id, _ := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/api/delayprofile/"))
+
+	switch r.Method {
+	case "GET":
+		var profiles []DelayProfile
+
+		for _, profile := range s.profiles {
+			profiles = append(profiles, profile)
+		}
+
+		// descending ids so the client has to sort
+		sort.Slice(profiles, func(i, j int) bool {
+			return profiles[i].ID > profiles[j].ID
+		})
+
+		json.NewEncoder(w).Encode(profiles)
+	case "POST":
+		var profile DelayProfile
+
+		json.NewDecoder(r.Body).Decode(&profile)
+
+		profile.ID = len(s.profiles) + 1
+		s.profiles[profile.ID] = profile
+
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(profile)
+	case "PUT":
+		if id == s.failPut {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		var profile DelayProfile
+
+		json.NewDecoder(r.Body).Decode(&profile)
+
+		if profile.ID != id {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		s.profiles[id] = profile
+
+		w.WriteHeader(http.StatusAccepted)
+		json.NewEncoder(w).Encode(profile)
+	case "DELETE":
+		if _, ok := s.profiles[id]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		delete(s.profiles, id)
+	}
+}
+
+func (s *delayProfileServer) order() []int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	orders := make([]int, len(s.profiles))
+
+	for id := 1; id <= len(s.profiles); id++ {
+		orders[id-1] = s.profiles[id].Order
+	}
+
+	return orders
+}
+
+func TestDelayProfiles(t *testing.T) {
+	fake := &delayProfileServer{profiles: map[int]DelayProfile{
+		1: {ID: 1, EnableUsenet: true, EnableTorrent: true, PreferredProtocol: ProtocolUsenet, Order: defaultDelayProfileOrder, Tags: []int{}},
+	}}
+
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	client, err := New(server.URL, "abc123")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tag, err := client.CreateTag("4k")
+
+	if err != nil || tag.ID != 1 || tag.Label != "4k" {
+		t.Fatalf("unexpected tag %+v: %v", tag, err)
+	}
+
+	if _, err := client.CreateTag(""); err == nil {
+		t.Error("expected an empty label to return an error")
+	}
+
+	tags, err := client.GetTags()
+
+	if err != nil || len(tags) != 1 {
+		t.Fatalf("unexpected tags %+v: %v", tags, err)
+	}
+
+	if _, err := client.CreateDelayProfile(DelayProfile{EnableTorrent: true, PreferredProtocol: ProtocolTorrent, Order: 1}); err == nil {
+		t.Error("expected a profile without tags to return an error")
+	}
+
+	for order := 1; order <= 3; order++ {
+		created, err := client.CreateDelayProfile(DelayProfile{EnableTorrent: true, PreferredProtocol: ProtocolTorrent, TorrentDelay: 60 * order, Order: order, Tags: []int{tag.ID}})
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if created.ID != order+1 || len(created.Tags) != 1 || created.Tags[0] != tag.ID {
+			t.Errorf("unexpected created profile: %+v", created)
+		}
+	}
+
+	profiles, err := client.GetDelayProfiles()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(profiles) != 4 || profiles[0].ID != 2 || !profiles[3].IsDefault() {
+		t.Fatalf("expected profiles sorted by order with the default last, got %+v", profiles)
+	}
+
+	profiles[0].UsenetDelay = -1
+
+	if _, err := client.UpdateDelayProfile(profiles[0]); err == nil {
+		t.Error("expected a negative delay to return an error")
+	}
+
+	// move 4 to the front, 2 and 3 keep their relative order
+	reordered, err := client.ReorderDelayProfiles([]int{4})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ids []int
+
+	for _, profile := range reordered {
+		ids = append(ids, profile.ID)
+	}
+
+	if len(ids) != 4 || ids[0] != 4 || ids[1] != 2 || ids[2] != 3 || ids[3] != 1 {
+		t.Errorf("unexpected reorder: %v", ids)
+	}
+
+	if orders := fake.order(); orders[1] != 2 || orders[2] != 3 || orders[3] != 1 || orders[0] != defaultDelayProfileOrder {
+		t.Errorf("unexpected orders on the server: %v", orders)
+	}
+
+	invalid := map[string][]int{
+		"delay profile 2 is listed twice":                         {2, 2},
+		"delay profile 9 doesn't exist or is the default profile": {2, 9, 8},
+		"delay profile 1 doesn't exist or is the default profile": {1},
+	}
+
+	for expected, ids := range invalid {
+		if _, err := client.ReorderDelayProfiles(ids); err == nil || err.Error() != expected {
+			t.Errorf("expected %v to be rejected with %q, got %v", ids, expected, err)
+		}
+	}
+
+	// the last update fails so the moved profiles are put back
+	fake.failPut = 4
+
+	if _, err := client.ReorderDelayProfiles([]int{2, 3, 4}); err == nil || !strings.Contains(err.Error(), "previous order was restored") {
+		t.Errorf("expected a failed reorder to be undone, got %v", err)
+	}
+
+	if orders := fake.order(); orders[1] != 2 || orders[2] != 3 || orders[3] != 1 {
+		t.Errorf("expected the previous order to be restored, got %v", orders)
+	}
+
+	if err := client.DeleteDelayProfile(2); err != nil {
+		t.Error(err)
+	}
+
+	if err := client.DeleteDelayProfile(2); err == nil {
+		t.Error("expected deleting a missing profile to return an error")
+	}
+}
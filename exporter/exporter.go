@@ -0,0 +1,333 @@
+// Package exporter exposes radarr's state as prometheus metrics using the
+// text exposition format, so it can be scraped without extra dependencies
+package exporter
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jrudio/go-radarr-client"
+)
+
+const namespace = "radarr"
+
+// DefaultBuckets upper bounds in seconds for the client latency histogram
+var DefaultBuckets = []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// Exporter scrapes radarr through the client every time it is collected
+type Exporter struct {
+	client  radarr.Client
+	buckets []float64
+
+	mu      sync.Mutex
+	latency map[string]*histogram
+}
+
+// New creates an exporter for the radarr instance behind client
+func New(client radarr.Client) *Exporter {
+	return &Exporter{
+		client:  client,
+		buckets: DefaultBuckets,
+		latency: map[string]*histogram{},
+	}
+}
+
+// ServeHTTP writes the current metrics, making the exporter usable as /metrics
+func (e *Exporter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var buf bytes.Buffer
+
+	if err := e.Collect(&buf); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	w.Write(buf.Bytes())
+}
+
+// Collect scrapes radarr and writes every metric to w. A failing radarr call
+// doesn't abort the scrape -- it is reported through radarr_up and
+// radarr_scrape_errors instead
+func (e *Exporter) Collect(w io.Writer) error {
+	var m metrics
+	var failures int
+
+	movies, err := e.movies()
+
+	if err != nil {
+		failures++
+	} else {
+		m.family("movies", "Movies in the library by monitored, downloaded and status", "gauge")
+
+		counts := map[[3]string]int{}
+
+		for _, movie := range movies {
+			key := [3]string{
+				strconv.FormatBool(movie.Monitored),
+				strconv.FormatBool(movie.Downloaded),
+				movie.Status.String(),
+			}
+
+			counts[key]++
+		}
+
+		keys := make([][3]string, 0, len(counts))
+
+		for key := range counts {
+			keys = append(keys, key)
+		}
+
+		sort.Slice(keys, func(i, j int) bool {
+			return strings.Join(keys[i][:], ",") < strings.Join(keys[j][:], ",")
+		})
+
+		for _, key := range keys {
+			m.sample("movies", labels{"monitored", key[0], "downloaded", key[1], "status", key[2]}, float64(counts[key]))
+		}
+	}
+
+	queue, err := e.queue()
+
+	if err != nil {
+		failures++
+	} else {
+		var total, left float64
+
+		for _, item := range queue {
+			total += item.Size
+			left += item.Sizeleft
+		}
+
+		m.family("queue_items", "Releases in the download queue", "gauge")
+		m.sample("queue_items", nil, float64(len(queue)))
+		m.family("queue_bytes", "Total size of the releases in the download queue", "gauge")
+		m.sample("queue_bytes", nil, total)
+		m.family("queue_bytes_remaining", "Bytes left to download in the queue", "gauge")
+		m.sample("queue_bytes_remaining", nil, left)
+	}
+
+	health, err := e.health()
+
+	if err != nil {
+		failures++
+	} else {
+		counts := map[radarr.HealthType]int{
+			radarr.HealthNotice:  0,
+			radarr.HealthWarning: 0,
+			radarr.HealthError:   0,
+		}
+
+		for _, check := range health {
+			counts[check.Type]++
+		}
+
+		severities := make([]radarr.HealthType, 0, len(counts))
+
+		for severity := range counts {
+			severities = append(severities, severity)
+		}
+
+		sort.Slice(severities, func(i, j int) bool {
+			return severities[i].Level() < severities[j].Level()
+		})
+
+		m.family("health_issues", "Health check issues by severity", "gauge")
+
+		for _, severity := range severities {
+			m.sample("health_issues", labels{"severity", string(severity)}, float64(counts[severity]))
+		}
+	}
+
+	folders, err := e.rootFolders()
+
+	if err != nil {
+		failures++
+	} else {
+		m.family("rootfolder_free_bytes", "Free space of each root folder", "gauge")
+
+		for _, folder := range folders {
+			m.sample("rootfolder_free_bytes", labels{"path", folder.Path}, float64(folder.FreeSpace))
+		}
+	}
+
+	up := 1.0
+
+	if failures > 0 {
+		up = 0
+	}
+
+	m.family("up", "Whether every call to radarr succeeded during the last scrape", "gauge")
+	m.sample("up", nil, up)
+	m.family("scrape_errors", "Calls to radarr that failed during the last scrape", "gauge")
+	m.sample("scrape_errors", nil, float64(failures))
+
+	e.writeLatency(&m)
+
+	_, err = w.Write(m.Bytes())
+
+	return err
+}
+
+func (e *Exporter) movies() ([]radarr.Movie, error) {
+	var movies []radarr.Movie
+
+	err := e.observe("movies", func() (err error) {
+		movies, err = e.client.GetMovies(radarr.GetMovieOptions{})
+		return err
+	})
+
+	return movies, err
+}
+
+func (e *Exporter) queue() ([]radarr.QueueItem, error) {
+	var queue []radarr.QueueItem
+
+	err := e.observe("queue", func() (err error) {
+		queue, err = e.client.GetQueue()
+		return err
+	})
+
+	return queue, err
+}
+
+func (e *Exporter) health() ([]radarr.Health, error) {
+	var health []radarr.Health
+
+	err := e.observe("health", func() (err error) {
+		health, err = e.client.GetHealth()
+		return err
+	})
+
+	return health, err
+}
+
+func (e *Exporter) rootFolders() ([]radarr.RootFolder, error) {
+	var folders []radarr.RootFolder
+
+	err := e.observe("rootfolder", func() (err error) {
+		folders, err = e.client.GetRootFolders()
+		return err
+	})
+
+	return folders, err
+}
+
+// observe times call and records it in the latency histogram for endpoint
+func (e *Exporter) observe(endpoint string, call func() error) error {
+	start := time.Now()
+	err := call()
+	elapsed := time.Since(start).Seconds()
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	h, ok := e.latency[endpoint]
+
+	if !ok {
+		h = newHistogram(e.buckets)
+		e.latency[endpoint] = h
+	}
+
+	h.observe(elapsed)
+
+	return err
+}
+
+func (e *Exporter) writeLatency(m *metrics) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	const name = "client_request_duration_seconds"
+
+	m.family(name, "Latency of the calls made to radarr by the client", "histogram")
+
+	endpoints := make([]string, 0, len(e.latency))
+
+	for endpoint := range e.latency {
+		endpoints = append(endpoints, endpoint)
+	}
+
+	sort.Strings(endpoints)
+
+	for _, endpoint := range endpoints {
+		h := e.latency[endpoint]
+
+		for i, bound := range h.bounds {
+			m.sample(name+"_bucket", labels{"endpoint", endpoint, "le", formatFloat(bound)}, float64(h.counts[i]))
+		}
+
+		m.sample(name+"_bucket", labels{"endpoint", endpoint, "le", "+Inf"}, float64(h.count))
+		m.sample(name+"_sum", labels{"endpoint", endpoint}, h.sum)
+		m.sample(name+"_count", labels{"endpoint", endpoint}, float64(h.count))
+	}
+}
+
+// histogram cumulative prometheus style histogram
+type histogram struct {
+	bounds []float64
+	counts []uint64
+	count  uint64
+	sum    float64
+}
+
+func newHistogram(bounds []float64) *histogram {
+	return &histogram{
+		bounds: bounds,
+		counts: make([]uint64, len(bounds)),
+	}
+}
+
+func (h *histogram) observe(value float64) {
+	for i, bound := range h.bounds {
+		if value <= bound {
+			h.counts[i]++
+		}
+	}
+
+	h.count++
+	h.sum += value
+}
+
+// labels alternating label names and values
+type labels []string
+
+// metrics builds the text exposition format
+type metrics struct {
+	bytes.Buffer
+}
+
+func (m *metrics) family(name, help, kind string) {
+	fmt.Fprintf(m, "# HELP %s_%s %s\n", namespace, name, help)
+	fmt.Fprintf(m, "# TYPE %s_%s %s\n", namespace, name, kind)
+}
+
+func (m *metrics) sample(name string, l labels, value float64) {
+	m.WriteString(namespace + "_" + name)
+
+	if len(l) > 0 {
+		pairs := make([]string, 0, len(l)/2)
+
+		for i := 0; i+1 < len(l); i += 2 {
+			pairs = append(pairs, l[i]+`="`+escapeLabel(l[i+1])+`"`)
+		}
+
+		m.WriteString("{" + strings.Join(pairs, ",") + "}")
+	}
+
+	m.WriteString(" " + formatFloat(value) + "\n")
+}
+
+func escapeLabel(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`).Replace(value)
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
@@ -0,0 +1,112 @@
+package exporter
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jrudio/go-radarr-client"
+)
+
+// newRadarr stands in for a radarr instance
+func newRadarr(t *testing.T) *httptest.Server {
+	responses := map[string]string{
+		"/api/movie": `[
+			{"title":"The Matrix","monitored":true,"downloaded":true,"status":"released"},
+			{"title":"Dune","monitored":true,"downloaded":false,"status":"released"},
+			{"title":"Blade Runner","monitored":true,"downloaded":false,"status":"released"},
+			{"title":"Avatar 3","monitored":false,"downloaded":false,"status":"announced"}
+		]`,
+		"/api/queue": `[
+			{"id":1,"title":"Dune.2021.1080p","size":1000,"sizeleft":250},
+			{"id":2,"title":"Blade.Runner.1982.2160p","size":3000,"sizeleft":3000}
+		]`,
+		"/api/health": `[
+			{"source":"IndexerStatusCheck","type":"warning","message":"Indexers unavailable"},
+			{"source":"UpdateCheck","type":"notice","message":"New update is available"}
+		]`,
+		"/api/rootfolder": `[{"id":1,"path":"/movies","freeSpace":5000}]`,
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.URL.Path]
+
+		if !ok {
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Write([]byte(body))
+	}))
+}
+
+func TestCollect(t *testing.T) {
+	server := newRadarr(t)
+	defer server.Close()
+
+	client, err := radarr.New(server.URL, "abc123")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+
+	if err := New(client).Collect(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	output := buf.String()
+
+	expected := []string{
+		`radarr_movies{monitored="true",downloaded="false",status="released"} 2`,
+		`radarr_movies{monitored="true",downloaded="true",status="released"} 1`,
+		`radarr_movies{monitored="false",downloaded="false",status="announced"} 1`,
+		`radarr_queue_items 2`,
+		`radarr_queue_bytes 4000`,
+		`radarr_queue_bytes_remaining 3250`,
+		`radarr_health_issues{severity="notice"} 1`,
+		`radarr_health_issues{severity="warning"} 1`,
+		`radarr_health_issues{severity="error"} 0`,
+		`radarr_rootfolder_free_bytes{path="/movies"} 5000`,
+		`radarr_up 1`,
+		`radarr_client_request_duration_seconds_count{endpoint="movies"} 1`,
+		`radarr_client_request_duration_seconds_bucket{endpoint="queue",le="+Inf"} 1`,
+	}
+
+	for _, line := range expected {
+		if !strings.Contains(output, line+"\n") {
+			t.Errorf("expected output to contain\n\t%s\ngot\n%s", line, output)
+		}
+	}
+}
+
+func TestCollectRadarrDown(t *testing.T) {
+	server := newRadarr(t)
+	client, err := radarr.New(server.URL, "abc123")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server.Close()
+
+	recorder := httptest.NewRecorder()
+
+	New(client).ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", recorder.Code)
+	}
+
+	output := recorder.Body.String()
+
+	for _, line := range []string{"radarr_up 0", "radarr_scrape_errors 4"} {
+		if !strings.Contains(output, line+"\n") {
+			t.Errorf("expected output to contain\n\t%s\ngot\n%s", line, output)
+		}
+	}
+}
@@ -0,0 +1,282 @@
+package radarr
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Unknown keeps what radarr sent that a model doesn't know about, so updates
+// don't drop it. Models embed it and pass it to unmarshalUnknown and
+// marshalUnknown from their UnmarshalJSON and MarshalJSON
+type Unknown struct {
+	// Extra fields radarr sent that aren't modeled, they are sent back on updates
+	Extra map[string]json.RawMessage `json:"-"`
+	// order the keys in the order radarr sent them
+	order []string
+	// source the values as radarr sent them. Fields the caller didn't change
+	// are written back from it, so nested fields the models don't know about,
+	// e.g. inside images, aren't dropped
+	source map[string]json.RawMessage
+	// decoded the known fields as they encoded right after decoding, by
+	// lowercased key, to tell which fields changed
+	decoded map[string]json.RawMessage
+}
+
+// unmarshalUnknown decodes data into v and keeps the fields T doesn't know
+// about in u. T must be a struct without its own UnmarshalJSON
+func unmarshalUnknown[T any](data []byte, v *T, u *Unknown) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil
+	}
+
+	order, source, err := objectFields(data)
+
+	if err != nil {
+		return err
+	}
+
+	// decoded from scratch so the baseline only holds what radarr sent
+	var decoded T
+
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	encoded, err := json.Marshal(decoded)
+
+	if err != nil {
+		return err
+	}
+
+	_, baseline, err := objectFields(encoded)
+
+	if err != nil {
+		return err
+	}
+
+	known := knownFields(reflect.TypeFor[T]())
+
+	var extra map[string]json.RawMessage
+
+	for _, key := range order {
+		if known[strings.ToLower(key)] {
+			continue
+		}
+
+		if extra == nil {
+			extra = map[string]json.RawMessage{}
+		}
+
+		extra[key] = source[key]
+	}
+
+	*v = decoded
+	*u = Unknown{Extra: extra, order: order, source: source, decoded: lowerKeys(baseline)}
+
+	return nil
+}
+
+// marshalUnknown encodes v and merges u.Extra back in. Keys keep the order
+// radarr sent them in and unchanged fields are written exactly as received.
+// Known fields radarr sent in another case than the model's are matched
+// ignoring case, like encoding/json does when decoding them.
+// T must be a struct without its own MarshalJSON
+func marshalUnknown[T any](v T, u Unknown) ([]byte, error) {
+	encoded, err := json.Marshal(v)
+
+	if err != nil {
+		return nil, err
+	}
+
+	order, fields, err := objectFields(encoded)
+
+	if err != nil {
+		return nil, err
+	}
+
+	current := lowerKeys(fields)
+
+	var buf bytes.Buffer
+	written := map[string]bool{}
+
+	write := func(key string, value json.RawMessage) {
+		if len(written) > 0 {
+			buf.WriteByte(',')
+		}
+
+		name, _ := json.Marshal(key)
+
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(value)
+
+		written[strings.ToLower(key)] = true
+	}
+
+	buf.WriteByte('{')
+
+	for _, key := range u.order {
+		lower := strings.ToLower(key)
+
+		if written[lower] {
+			continue
+		}
+
+		value, known := current[lower]
+
+		switch {
+		case known && bytes.Equal(value, u.decoded[lower]):
+			write(key, u.source[key])
+		case known:
+			write(key, value)
+		default:
+			if value, ok := u.Extra[key]; ok {
+				write(key, value)
+			}
+		}
+	}
+
+	for _, key := range order {
+		lower := strings.ToLower(key)
+
+		if written[lower] {
+			continue
+		}
+
+		// known fields radarr didn't send are only written once they're set
+		if u.decoded != nil && bytes.Equal(current[lower], u.decoded[lower]) {
+			continue
+		}
+
+		write(key, current[lower])
+	}
+
+	keys := make([]string, 0, len(u.Extra))
+
+	for key := range u.Extra {
+		if !written[strings.ToLower(key)] {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		write(key, u.Extra[key])
+	}
+
+	buf.WriteByte('}')
+
+	var compact bytes.Buffer
+
+	if err := json.Compact(&compact, buf.Bytes()); err != nil {
+		return nil, err
+	}
+
+	return compact.Bytes(), nil
+}
+
+// lowerKeys the same fields keyed by their lowercased names
+func lowerKeys(fields map[string]json.RawMessage) map[string]json.RawMessage {
+	lowered := make(map[string]json.RawMessage, len(fields))
+
+	for key, value := range fields {
+		lowered[strings.ToLower(key)] = value
+	}
+
+	return lowered
+}
+
+// knownNames the lowercased json names of each model's fields by type
+var knownNames sync.Map
+
+// knownFields the lowercased json names encoding/json decodes into a struct
+// of type t. Like encoding/json, names are matched ignoring case
+func knownFields(t reflect.Type) map[string]bool {
+	if names, ok := knownNames.Load(t); ok {
+		return names.(map[string]bool)
+	}
+
+	names := map[string]bool{}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+
+		if tag == "-" {
+			continue
+		}
+
+		name, _, _ := strings.Cut(tag, ",")
+
+		// untagged embedded structs have their fields promoted
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			for promoted := range knownFields(field.Type) {
+				names[promoted] = true
+			}
+
+			continue
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		names[strings.ToLower(name)] = true
+	}
+
+	knownNames.Store(t, names)
+
+	return names
+}
+
+// objectFields splits a json object into its keys, in order, and raw values
+func objectFields(data []byte) ([]string, map[string]json.RawMessage, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+
+	token, err := decoder.Token()
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if token != json.Delim('{') {
+		return nil, nil, errors.New("expected a json object")
+	}
+
+	var order []string
+	fields := map[string]json.RawMessage{}
+
+	for decoder.More() {
+		token, err := decoder.Token()
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		key, _ := token.(string)
+
+		var value json.RawMessage
+
+		if err := decoder.Decode(&value); err != nil {
+			return nil, nil, err
+		}
+
+		if _, duplicate := fields[key]; !duplicate {
+			order = append(order, key)
+		}
+
+		fields[key] = value
+	}
+
+	return order, fields, nil
+}
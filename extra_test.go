@@ -0,0 +1,200 @@
+package radarr
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+)
+
+type roundTripper interface {
+	json.Marshaler
+	json.Unmarshaler
+}
+
+func TestRoundTripFixtures(t *testing.T) {
+	fixtures := map[string]func() roundTripper{
+		"testdata/movie.json":           func() roundTripper { return &Movie{} },
+		"testdata/collection.json":      func() roundTripper { return &Collection{} },
+		"testdata/customformat.json":    func() roundTripper { return &CustomFormat{} },
+		"testdata/delayprofile.json":    func() roundTripper { return &DelayProfile{} },
+		"testdata/hostconfig.json":      func() roundTripper { return &HostConfig{} },
+		"testdata/mediamanagement.json": func() roundTripper { return &MediaManagementConfig{} },
+		"testdata/metadata.json":        func() roundTripper { return &MetadataConsumer{} },
+		"testdata/naming.json":          func() roundTripper { return &NamingConfig{} },
+		"testdata/remotepath.json":      func() roundTripper { return &RemotePathMapping{} },
+		"testdata/restriction.json":     func() roundTripper { return &Restriction{} },
+	}
+
+	for fixture, model := range fixtures {
+		data, err := os.ReadFile(fixture)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var expected bytes.Buffer
+
+		if err := json.Compact(&expected, data); err != nil {
+			t.Fatal(err)
+		}
+
+		decoded := model()
+
+		if err := json.Unmarshal(data, decoded); err != nil {
+			t.Fatalf("%s: %v", fixture, err)
+		}
+
+		encoded, err := decoded.MarshalJSON()
+
+		if err != nil {
+			t.Fatalf("%s: %v", fixture, err)
+		}
+
+		if !bytes.Equal(encoded, expected.Bytes()) {
+			t.Errorf("%s: round trip changed the json\nexpected %s\ngot      %s", fixture, expected.Bytes(), encoded)
+		}
+	}
+}
+
+func TestRoundTripChanges(t *testing.T) {
+	data, err := os.ReadFile("testdata/movie.json")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var movie Movie
+
+	if err := json.Unmarshal(data, &movie); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, key := range []string{"originalTitle", "digitalRelease", "certification", "movieFile", "collection"} {
+		if _, ok := movie.Extra[key]; !ok {
+			t.Errorf("expected %s to be kept in Extra", key)
+		}
+	}
+
+	if _, ok := movie.Extra["title"]; ok {
+		t.Error("expected modeled fields to stay out of Extra")
+	}
+
+	movie.Monitored = false
+	movie.Images = movie.Images[:1]
+	movie.Extra["certification"] = json.RawMessage(`"PG-13"`)
+
+	encoded, err := movie.MarshalJSON()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var fields map[string]json.RawMessage
+
+	if err := json.Unmarshal(encoded, &fields); err != nil {
+		t.Fatal(err)
+	}
+
+	if string(fields["monitored"]) != "false" || string(fields["certification"]) != `"PG-13"` {
+		t.Errorf("expected changes to be written, got %s", encoded)
+	}
+
+	// changed fields are written from the model, so unmodeled nested fields are lost
+	if strings.Contains(string(fields["images"]), "remoteUrl") {
+		t.Errorf("expected changed images to be written from the model, got %s", fields["images"])
+	}
+
+	if !strings.Contains(string(fields["movieFile"]), `"mediaInfo"`) {
+		t.Errorf("expected unmodeled fields to be written back, got %s", fields["movieFile"])
+	}
+
+	for _, key := range []string{"deleted", "episodeCount", "saved"} {
+		if _, ok := fields[key]; ok {
+			t.Errorf("expected %s radarr didn't send to stay unset", key)
+		}
+	}
+
+	// movies that weren't decoded are written as before
+	encoded, err = json.Marshal(Movie{Title: "Heat"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasPrefix(string(encoded), `{"added":"","addOptions":`) || !strings.Contains(string(encoded), `"title":"Heat"`) {
+		t.Errorf("unexpected encoding of a new movie: %s", encoded)
+	}
+}
+
+func TestUnknownFields(t *testing.T) {
+	data, err := os.ReadFile("testdata/restriction.json")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var restriction Restriction
+
+	if err := json.Unmarshal(data, &restriction); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(restriction.Extra) != 2 || restriction.Extra["preferred"] == nil || restriction.Extra["includePreferredWhenRenaming"] == nil {
+		t.Errorf("expected only the unmodeled fields in Extra, got %v", restriction.Extra)
+	}
+
+	// like encoding/json, modeled fields match ignoring case
+	cased := `{"id":3,"Required":"x264","IGNORED":"cam","preferred":[]}`
+
+	if err := json.Unmarshal([]byte(cased), &restriction); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(restriction.Extra) != 1 || restriction.Required != "x264" || restriction.Ignored != "cam" {
+		t.Errorf("expected differently cased fields to be decoded, got %+v", restriction)
+	}
+
+	encoded, err := json.Marshal(restriction)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(encoded) != cased {
+		t.Errorf("expected differently cased fields to be written back as received\nexpected %s\ngot      %s", cased, encoded)
+	}
+
+	restriction.Ignored = "cam,ts"
+
+	encoded, _ = json.Marshal(restriction)
+
+	if expected := `{"id":3,"Required":"x264","IGNORED":"cam,ts","preferred":[]}`; string(encoded) != expected {
+		t.Errorf("expected a changed field to be written once under the received key\nexpected %s\ngot      %s", expected, encoded)
+	}
+
+	data, err = os.ReadFile("testdata/customformat.json")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var format CustomFormat
+
+	if err := json.Unmarshal(data, &format); err != nil {
+		t.Fatal(err)
+	}
+
+	format.Name = "x265 (no 4k)"
+
+	encoded, err = json.Marshal(format)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(string(encoded), `"name":"x265 (no 4k)"`) || !strings.Contains(string(encoded), `"dividerAfter":false`) {
+		t.Errorf("expected the new name along with the unchanged specifications as received, got %s", encoded)
+	}
+}
@@ -0,0 +1,139 @@
+package radarr
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// ExtraFileType what kind of extra file radarr imported next to a movie
+type ExtraFileType string
+
+const (
+	// ExtraFileSubtitle e.g. .srt or .ass
+	ExtraFileSubtitle ExtraFileType = "subtitle"
+	// ExtraFileMetadata nfo and images written by a metadata consumer
+	ExtraFileMetadata ExtraFileType = "metadata"
+	// ExtraFileOther any other extension listed in the media management settings
+	ExtraFileOther ExtraFileType = "other"
+)
+
+// ExtraFile a file radarr imported or wrote alongside a movie file
+type ExtraFile struct {
+	ID          int `json:"id"`
+	MovieID     int `json:"movieId"`
+	MovieFileID int `json:"movieFileId"`
+	// RelativePath to the movie folder
+	RelativePath string `json:"relativePath"`
+	Extension    string `json:"extension"`
+	// Language of a subtitle, e.g. english
+	Language string `json:"language"`
+	// LanguageTags extra tags of a subtitle, e.g. forced or sdh
+	LanguageTags []string      `json:"languageTags"`
+	Type         ExtraFileType `json:"type"`
+}
+
+// GetExtraFiles returns every extra file of a movie
+// movieID is the id for the movie in the radarr library
+func (c Client) GetExtraFiles(movieID int) ([]ExtraFile, error) {
+	const endpoint = "/api/extrafile"
+
+	var files []ExtraFile
+
+	params := url.Values{}
+
+	params.Set("movieId", strconv.Itoa(movieID))
+
+	resp, err := c.get(endpoint, params)
+
+	if err != nil {
+		return files, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return files, errors.New(resp.Status)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&files)
+
+	return files, err
+}
+
+// GetExtraFilesOfType returns the extra files of a movie of one type, e.g. subtitles
+func (c Client) GetExtraFilesOfType(movieID int, fileType ExtraFileType) ([]ExtraFile, error) {
+	files, err := c.GetExtraFiles(movieID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var filtered []ExtraFile
+
+	for _, file := range files {
+		if file.Type == fileType {
+			filtered = append(filtered, file)
+		}
+	}
+
+	return filtered, nil
+}
+
+// MoviesMissingSubtitles returns the downloaded library movies without a
+// subtitle in language, e.g. english. An empty language returns movies
+// without any subtitle. This fetches the extra files of every downloaded
+// movie so it can take a while on large libraries
+func (c Client) MoviesMissingSubtitles(language string) ([]Movie, error) {
+	movies, err := c.GetMovies(GetMovieOptions{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	var downloaded []Movie
+
+	for _, movie := range movies {
+		if movie.HasFile {
+			downloaded = append(downloaded, movie)
+		}
+	}
+
+	missing := make([]bool, len(downloaded))
+
+	err = eachMovie(downloaded, func(i int, movie Movie) error {
+		subtitles, err := c.GetExtraFilesOfType(movie.ID, ExtraFileSubtitle)
+
+		if err != nil {
+			return err
+		}
+
+		missing[i] = true
+
+		for _, subtitle := range subtitles {
+			if language == "" || strings.EqualFold(subtitle.Language, language) {
+				missing[i] = false
+				break
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	var found []Movie
+
+	for i, movie := range downloaded {
+		if missing[i] {
+			found = append(found, movie)
+		}
+	}
+
+	return found, nil
+}
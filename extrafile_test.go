@@ -0,0 +1,92 @@
+package radarr
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestExtraFiles(t *testing.T) {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/api/movie", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"id":1,"title":"Heat","hasFile":true},{"id":2,"title":"Alien","hasFile":true},{"id":3,"title":"Dune","hasFile":false}]`))
+	})
+
+	mux.HandleFunc("/api/extrafile", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("movieId") {
+		case "1":
+			w.Write([]byte(`[{"id":10,"movieId":1,"movieFileId":4,"relativePath":"Heat (1995).en.forced.srt","extension":".srt","language":"english","languageTags":["forced"],"type":"subtitle"},{"id":11,"movieId":1,"movieFileId":4,"relativePath":"Heat (1995).nfo","extension":".nfo","type":"metadata"},{"id":12,"movieId":1,"movieFileId":4,"relativePath":"Heat (1995).de.srt","extension":".srt","language":"german","type":"subtitle"}]`))
+		case "2":
+			w.Write([]byte(`[{"id":20,"movieId":2,"movieFileId":5,"relativePath":"Alien (1979).de.srt","extension":".srt","language":"german","type":"subtitle"}]`))
+		case "3":
+			t.Error("expected movies without a file to be skipped")
+			w.Write([]byte(`[]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := New(server.URL, "abc123")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := client.GetExtraFiles(1)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(files) != 3 {
+		t.Fatalf("expected 3 extra files, got %+v", files)
+	}
+
+	subtitle := files[0]
+
+	if subtitle.ID != 10 || subtitle.MovieFileID != 4 || subtitle.Type != ExtraFileSubtitle || subtitle.Language != "english" ||
+		len(subtitle.LanguageTags) != 1 || subtitle.LanguageTags[0] != "forced" || subtitle.RelativePath != "Heat (1995).en.forced.srt" {
+		t.Errorf("unexpected extra file: %+v", subtitle)
+	}
+
+	subtitles, err := client.GetExtraFilesOfType(1, ExtraFileSubtitle)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(subtitles) != 2 || subtitles[0].ID != 10 || subtitles[1].ID != 12 {
+		t.Errorf("expected only the subtitles, got %+v", subtitles)
+	}
+
+	metadata, _ := client.GetExtraFilesOfType(1, ExtraFileMetadata)
+	other, _ := client.GetExtraFilesOfType(1, ExtraFileOther)
+
+	if len(metadata) != 1 || metadata[0].ID != 11 || len(other) != 0 {
+		t.Errorf("unexpected metadata %+v and other %+v files", metadata, other)
+	}
+
+	missing, err := client.MoviesMissingSubtitles("English")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(missing) != 1 || missing[0].ID != 2 {
+		t.Errorf("expected only Alien to be missing english subtitles, got %+v", missing)
+	}
+
+	missing, err = client.MoviesMissingSubtitles("")
+
+	if err != nil || len(missing) != 0 {
+		t.Errorf("expected every downloaded movie to have a subtitle, got %+v %v", missing, err)
+	}
+
+	if _, err := client.GetExtraFiles(9); err == nil {
+		t.Error("expected a non-200 status to return an error")
+	}
+}
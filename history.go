@@ -0,0 +1,103 @@
+package radarr
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// HistoryRecord an event in a movie's history, e.g. a grab, import or failed download
+type HistoryRecord struct {
+	ID          int    `json:"id"`
+	MovieID     int    `json:"movieId"`
+	SourceTitle string `json:"sourceTitle"`
+	Quality     struct {
+		Quality struct {
+			ID   int    `json:"id"`
+			Name string `json:"name"`
+		} `json:"quality"`
+	} `json:"quality"`
+	Date string `json:"date"`
+	// EventType e.g. 'grabbed', 'downloadFolderImported' or 'downloadFailed'
+	EventType  string `json:"eventType"`
+	DownloadID string `json:"downloadId"`
+	// Data details that depend on the event type, e.g. the indexer of a grab
+	Data  map[string]string `json:"data"`
+	Movie Movie             `json:"movie"`
+}
+
+// History a page of history records
+type History struct {
+	Page          int             `json:"page"`
+	PageSize      int             `json:"pageSize"`
+	SortKey       string          `json:"sortKey"`
+	SortDirection string          `json:"sortDirection"`
+	TotalRecords  int             `json:"totalRecords"`
+	Records       []HistoryRecord `json:"records"`
+}
+
+// HistoryOptions change the params when using GetHistory
+type HistoryOptions struct {
+	// Page defaults to 1
+	Page int
+	// PageSize defaults to 20
+	PageSize int
+	// SortKey can be 'date' or 'movie.sortTitle' -- defaults to 'date'
+	SortKey string
+	// SortDir can be 'asc' or 'desc' -- defaults to 'desc'
+	SortDir string
+	// MovieID only returns the history of this movie when set
+	MovieID int
+}
+
+// GetHistory returns a page of radarr's history
+func (c Client) GetHistory(options HistoryOptions) (History, error) {
+	const endpoint = "/api/history"
+
+	var history History
+
+	if options.Page == 0 {
+		options.Page = 1
+	}
+
+	if options.PageSize == 0 {
+		options.PageSize = 20
+	}
+
+	if options.SortKey == "" {
+		options.SortKey = "date"
+	}
+
+	if options.SortDir == "" {
+		options.SortDir = "desc"
+	}
+
+	params := url.Values{}
+
+	params.Set("page", strconv.Itoa(options.Page))
+	params.Set("pageSize", strconv.Itoa(options.PageSize))
+	params.Set("sortKey", options.SortKey)
+	params.Set("sortDir", options.SortDir)
+
+	if options.MovieID != 0 {
+		params.Set("movieId", strconv.Itoa(options.MovieID))
+	}
+
+	resp, err := c.get(endpoint, params)
+
+	if err != nil {
+		return history, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return history, errors.New(resp.Status)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&history)
+
+	return history, err
+}
@@ -0,0 +1,50 @@
+package radarr
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHistory(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+
+		if r.URL.Path != "/api/history" {
+			t.Errorf("unexpected request: %s", r.URL)
+		}
+
+		if query.Get("page") != "1" || query.Get("pageSize") != "20" || query.Get("sortKey") != "date" || query.Get("sortDir") != "desc" {
+			t.Errorf("expected the default paging, got %s", r.URL.RawQuery)
+		}
+
+		if query.Get("movieId") != "12" || query.Has("filterKey") {
+			t.Errorf("expected a movie filter, got %s", r.URL.RawQuery)
+		}
+
+		w.Write([]byte(`{"page":1,"pageSize":20,"sortKey":"date","sortDirection":"descending","totalRecords":1,"records":[{"id":3,"movieId":12,"sourceTitle":"Heat.1995.1080p.BluRay.x264-GRP","quality":{"quality":{"id":7,"name":"Bluray-1080p"}},"date":"2019-11-24T18:30:02Z","eventType":"grabbed","downloadId":"ABC","data":{"indexer":"Indexer"}}]}`))
+	}))
+	defer server.Close()
+
+	client, err := New(server.URL, "abc123")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	history, err := client.GetHistory(HistoryOptions{MovieID: 12})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if history.TotalRecords != 1 || len(history.Records) != 1 {
+		t.Fatalf("unexpected history: %+v", history)
+	}
+
+	record := history.Records[0]
+
+	if record.MovieID != 12 || record.EventType != "grabbed" || record.Quality.Quality.Name != "Bluray-1080p" || record.Data["indexer"] != "Indexer" {
+		t.Errorf("unexpected record: %+v", record)
+	}
+}
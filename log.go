@@ -0,0 +1,181 @@
+package radarr
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// LogLevel severity of a log record
+type LogLevel string
+
+const (
+	// LogTrace most verbose level
+	LogTrace LogLevel = "trace"
+	// LogDebug debug level
+	LogDebug LogLevel = "debug"
+	// LogInfo info level
+	LogInfo LogLevel = "info"
+	// LogWarn warn level
+	LogWarn LogLevel = "warn"
+	// LogError error level
+	LogError LogLevel = "error"
+	// LogFatal fatal level
+	LogFatal LogLevel = "fatal"
+)
+
+// LogRecord a single line from radarr's log
+type LogRecord struct {
+	ID            int      `json:"id"`
+	Time          string   `json:"time"`
+	Exception     string   `json:"exception"`
+	ExceptionType string   `json:"exceptionType"`
+	Level         LogLevel `json:"level"`
+	Logger        string   `json:"logger"`
+	Message       string   `json:"message"`
+}
+
+// LogPage a page of log records
+type LogPage struct {
+	Page          int         `json:"page"`
+	PageSize      int         `json:"pageSize"`
+	SortKey       string      `json:"sortKey"`
+	SortDirection string      `json:"sortDirection"`
+	TotalRecords  int         `json:"totalRecords"`
+	Records       []LogRecord `json:"records"`
+}
+
+// LogOptions change the params when using GetLogs
+type LogOptions struct {
+	// Page defaults to 1
+	Page int
+	// PageSize defaults to 50
+	PageSize int
+	// SortKey defaults to 'time'
+	SortKey string
+	// SortDir defaults to 'desc' so the most recent records come first
+	SortDir string
+	// Level only returns records of this level or more severe, e.g. LogWarn
+	// returns warn, error and fatal records. Radarr applies the floor itself
+	// and only knows the capitalized names, which GetLogs sends regardless of
+	// the case used here. Empty returns every level
+	Level LogLevel
+}
+
+// LogFile a log file radarr has written to disk
+type LogFile struct {
+	ID            int    `json:"id"`
+	Filename      string `json:"filename"`
+	LastWriteTime string `json:"lastWriteTime"`
+	ContentsURL   string `json:"contentsUrl"`
+	DownloadURL   string `json:"downloadUrl"`
+}
+
+// GetLogs returns a page of radarr's log
+func (c Client) GetLogs(options LogOptions) (LogPage, error) {
+	const endpoint = "/api/log"
+
+	var logs LogPage
+
+	if options.Page == 0 {
+		options.Page = 1
+	}
+
+	if options.PageSize == 0 {
+		options.PageSize = 50
+	}
+
+	if options.SortKey == "" {
+		options.SortKey = "time"
+	}
+
+	if options.SortDir == "" {
+		options.SortDir = "desc"
+	}
+
+	params := url.Values{}
+
+	params.Set("page", strconv.Itoa(options.Page))
+	params.Set("pageSize", strconv.Itoa(options.PageSize))
+	params.Set("sortKey", options.SortKey)
+	params.Set("sortDir", options.SortDir)
+
+	if options.Level != "" {
+		level := strings.ToLower(string(options.Level))
+
+		params.Set("filterKey", "level")
+		params.Set("filterValue", strings.ToUpper(level[:1])+level[1:])
+	}
+
+	resp, err := c.get(endpoint, params)
+
+	if err != nil {
+		return logs, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return logs, errors.New(resp.Status)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&logs)
+
+	return logs, err
+}
+
+// GetLogFiles returns radarr's log files
+func (c Client) GetLogFiles() ([]LogFile, error) {
+	return c.getLogFiles("/api/log/file")
+}
+
+// GetUpdateLogFiles returns the log files written while radarr updated itself
+func (c Client) GetUpdateLogFiles() ([]LogFile, error) {
+	return c.getLogFiles("/api/log/file/update")
+}
+
+func (c Client) getLogFiles(endpoint string) ([]LogFile, error) {
+	var files []LogFile
+
+	resp, err := c.get(endpoint, nil)
+
+	if err != nil {
+		return files, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return files, errors.New(resp.Status)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&files)
+
+	return files, err
+}
+
+// DownloadLogFile streams the contents of a log file to w and returns the
+// bytes written
+func (c Client) DownloadLogFile(file LogFile, w io.Writer) (int64, error) {
+	if file.ContentsURL == "" {
+		return 0, errors.New("log file contents url is required")
+	}
+
+	resp, err := c.get(file.ContentsURL, nil)
+
+	if err != nil {
+		return 0, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, errors.New(resp.Status)
+	}
+
+	return io.Copy(w, resp.Body)
+}
@@ -0,0 +1,85 @@
+package radarr
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestLogs(t *testing.T) {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/api/log", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+
+		if query.Get("page") != "1" || query.Get("pageSize") != "50" || query.Get("sortKey") != "time" || query.Get("sortDir") != "desc" {
+			t.Errorf("expected the default paging, got %s", r.URL.RawQuery)
+		}
+
+		if query.Get("filterKey") != "level" || query.Get("filterValue") != "Warn" || query.Has("filterType") {
+			t.Errorf("expected a warn level filter, got %s", r.URL.RawQuery)
+		}
+
+		w.Write([]byte(`{"page":1,"pageSize":50,"sortKey":"time","sortDirection":"descending","totalRecords":1,"records":[{"id":7,"time":"2019-11-24T18:30:02Z","level":"warn","logger":"RssSyncService","message":"Indexer unavailable"}]}`))
+	})
+
+	mux.HandleFunc("/api/log/file", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"id":1,"filename":"radarr.txt","lastWriteTime":"2019-11-24T18:30:02Z","contentsUrl":"/api/log/file/radarr.txt","downloadUrl":"/logfile/radarr.txt"}]`))
+	})
+
+	mux.HandleFunc("/api/log/file/update", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	mux.HandleFunc("/api/log/file/radarr.txt", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("19-11-24 18:30:02.2|Info|Bootstrap|Starting Radarr\n"))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := New(server.URL, "abc123")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	logs, err := client.GetLogs(LogOptions{Level: LogWarn})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if logs.TotalRecords != 1 || len(logs.Records) != 1 || logs.Records[0].Level != LogWarn || logs.Records[0].Logger != "RssSyncService" {
+		t.Errorf("unexpected log page: %+v", logs)
+	}
+
+	files, err := client.GetLogFiles()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(files) != 1 || files[0].Filename != "radarr.txt" {
+		t.Fatalf("unexpected log files: %+v", files)
+	}
+
+	var contents bytes.Buffer
+
+	if _, err := client.DownloadLogFile(files[0], &contents); err != nil {
+		t.Fatal(err)
+	}
+
+	if contents.String() != "19-11-24 18:30:02.2|Info|Bootstrap|Starting Radarr\n" {
+		t.Errorf("unexpected log file contents: %q", contents.String())
+	}
+
+	if _, err := client.DownloadLogFile(LogFile{}, &contents); err == nil {
+		t.Error("expected a log file without a contents url to return an error")
+	}
+
+	if _, err := client.GetUpdateLogFiles(); err == nil {
+		t.Error("expected a non-200 status to return an error")
+	}
+}
@@ -0,0 +1,178 @@
+package radarr
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+)
+
+// FileDate what radarr sets the modified date of imported files to
+type FileDate string
+
+const (
+	// FileDateNone leaves the date alone
+	FileDateNone FileDate = "none"
+	// FileDateCinemas uses the date the movie was in cinemas
+	FileDateCinemas FileDate = "cinemas"
+	// FileDateRelease uses the physical release date
+	FileDateRelease FileDate = "release"
+)
+
+// RescanAfterRefresh when radarr rescans a movie folder after refreshing the movie
+type RescanAfterRefresh string
+
+const (
+	// RescanAlways rescan after every refresh
+	RescanAlways RescanAfterRefresh = "always"
+	// RescanAfterManual rescan only after a manual refresh
+	RescanAfterManual RescanAfterRefresh = "afterManual"
+	// RescanNever never rescan
+	RescanNever RescanAfterRefresh = "never"
+)
+
+// ProperDownloadType whether radarr upgrades to propers and repacks
+type ProperDownloadType string
+
+const (
+	// ProperPreferAndUpgrade always upgrade to propers and repacks
+	ProperPreferAndUpgrade ProperDownloadType = "preferAndUpgrade"
+	// ProperDoNotUpgrade prefer propers when grabbing but don't upgrade to them
+	ProperDoNotUpgrade ProperDownloadType = "doNotUpgrade"
+	// ProperDoNotPrefer let custom formats decide
+	ProperDoNotPrefer ProperDownloadType = "doNotPrefer"
+)
+
+// MediaManagementConfig how radarr imports, organizes and deletes files
+type MediaManagementConfig struct {
+	ID                                      int                `json:"id"`
+	AutoUnmonitorPreviouslyDownloadedMovies bool               `json:"autoUnmonitorPreviouslyDownloadedMovies"`
+	RecycleBin                              string             `json:"recycleBin"`
+	RecycleBinCleanupDays                   int                `json:"recycleBinCleanupDays"`
+	DownloadPropersAndRepacks               ProperDownloadType `json:"downloadPropersAndRepacks"`
+	CreateEmptyMovieFolders                 bool               `json:"createEmptyMovieFolders"`
+	DeleteEmptyFolders                      bool               `json:"deleteEmptyFolders"`
+	FileDate                                FileDate           `json:"fileDate"`
+	RescanAfterRefresh                      RescanAfterRefresh `json:"rescanAfterRefresh"`
+	AutoRenameFolders                       bool               `json:"autoRenameFolders"`
+	PathsDefaultStatic                      bool               `json:"pathsDefaultStatic"`
+	SetPermissionsLinux                     bool               `json:"setPermissionsLinux"`
+	ChmodFolder                             string             `json:"chmodFolder"`
+	ChownGroup                              string             `json:"chownGroup"`
+	SkipFreeSpaceCheckWhenImporting         bool               `json:"skipFreeSpaceCheckWhenImporting"`
+	// MinimumFreeSpaceWhenImporting in megabytes
+	MinimumFreeSpaceWhenImporting int `json:"minimumFreeSpaceWhenImporting"`
+	// CopyUsingHardlinks hardlink instead of copying torrents that are still seeding
+	CopyUsingHardlinks bool `json:"copyUsingHardlinks"`
+	ImportExtraFiles   bool `json:"importExtraFiles"`
+	// ExtraFileExtensions comma separated, e.g. srt,nfo
+	ExtraFileExtensions string `json:"extraFileExtensions"`
+	EnableMediaInfo     bool   `json:"enableMediaInfo"`
+
+	Unknown
+}
+
+// UnmarshalJSON decodes the media management config and keeps the fields it doesn't model in Extra
+func (m *MediaManagementConfig) UnmarshalJSON(data []byte) error {
+	type plain MediaManagementConfig
+
+	return unmarshalUnknown(data, (*plain)(m), &m.Unknown)
+}
+
+// MarshalJSON encodes the media management config with Extra merged back in
+func (m MediaManagementConfig) MarshalJSON() ([]byte, error) {
+	type plain MediaManagementConfig
+
+	return marshalUnknown(plain(m), m.Unknown)
+}
+
+var chmodRe = regexp.MustCompile(`^[0-7]{3,4}$`)
+
+// GetMediaManagementConfig returns radarr's media management settings
+func (c Client) GetMediaManagementConfig() (MediaManagementConfig, error) {
+	const endpoint = "/api/config/mediamanagement"
+	var config MediaManagementConfig
+
+	resp, err := c.get(endpoint, nil)
+
+	if err != nil {
+		return config, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return config, errors.New(resp.Status)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&config)
+
+	return config, err
+}
+
+// UpdateMediaManagementConfig saves radarr's media management settings.
+// Pass a config from GetMediaManagementConfig with the changes applied so
+// settings you don't touch keep their values
+func (c Client) UpdateMediaManagementConfig(config MediaManagementConfig) (MediaManagementConfig, error) {
+	const endpoint = "/api/config/mediamanagement"
+
+	// check values radarr would reject
+	switch config.FileDate {
+	case FileDateNone, FileDateCinemas, FileDateRelease:
+	default:
+		return config, fmt.Errorf("unknown file date: %q", config.FileDate)
+	}
+
+	switch config.RescanAfterRefresh {
+	case RescanAlways, RescanAfterManual, RescanNever:
+	default:
+		return config, fmt.Errorf("unknown rescan after refresh: %q", config.RescanAfterRefresh)
+	}
+
+	switch config.DownloadPropersAndRepacks {
+	case ProperPreferAndUpgrade, ProperDoNotUpgrade, ProperDoNotPrefer:
+	default:
+		return config, fmt.Errorf("unknown download propers and repacks: %q", config.DownloadPropersAndRepacks)
+	}
+
+	if config.RecycleBinCleanupDays < 0 {
+		return config, errors.New("recycle bin cleanup days can't be negative")
+	}
+
+	if config.MinimumFreeSpaceWhenImporting < 0 {
+		return config, errors.New("minimum free space when importing can't be negative")
+	}
+
+	if config.SetPermissionsLinux && !chmodRe.MatchString(config.ChmodFolder) {
+		return config, fmt.Errorf("chmod folder must be an octal permission, e.g. 755, got %q", config.ChmodFolder)
+	}
+
+	if config.ImportExtraFiles && config.ExtraFileExtensions == "" {
+		return config, errors.New("extra file extensions are required when importing extra files")
+	}
+
+	requestPayload, err := json.Marshal(config)
+
+	if err != nil {
+		return config, err
+	}
+
+	resp, err := c.put(endpoint, requestPayload)
+
+	if err != nil {
+		return config, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusAccepted && resp.StatusCode != http.StatusOK {
+		return config, errors.New(resp.Status)
+	}
+
+	var updated MediaManagementConfig
+
+	err = json.NewDecoder(resp.Body).Decode(&updated)
+
+	return updated, err
+}
@@ -0,0 +1,92 @@
+package radarr
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestMediaManagementConfig(t *testing.T) {
+	const config = `{"id":1,"autoUnmonitorPreviouslyDownloadedMovies":false,"recycleBin":"/trash","recycleBinCleanupDays":7,"downloadPropersAndRepacks":"preferAndUpgrade","createEmptyMovieFolders":false,"deleteEmptyFolders":true,"fileDate":"none","rescanAfterRefresh":"always","autoRenameFolders":false,"pathsDefaultStatic":false,"setPermissionsLinux":false,"chmodFolder":"755","chownGroup":"","skipFreeSpaceCheckWhenImporting":false,"minimumFreeSpaceWhenImporting":100,"copyUsingHardlinks":true,"importExtraFiles":true,"extraFileExtensions":"srt,nfo","enableMediaInfo":true}`
+
+	var puts int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/config/mediamanagement" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+
+		if r.Method == "PUT" {
+			puts++
+
+			body, _ := io.ReadAll(r.Body)
+
+			var sent MediaManagementConfig
+
+			if err := json.Unmarshal(body, &sent); err != nil || sent.FileDate != FileDateCinemas || sent.RecycleBin != "/trash" {
+				t.Errorf("unexpected payload: %s", body)
+			}
+
+			w.WriteHeader(http.StatusAccepted)
+			w.Write(body)
+
+			return
+		}
+
+		w.Write([]byte(config))
+	}))
+	defer server.Close()
+
+	client, err := New(server.URL, "abc123")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	current, err := client.GetMediaManagementConfig()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if current.RecycleBinCleanupDays != 7 || current.DownloadPropersAndRepacks != ProperPreferAndUpgrade || current.ExtraFileExtensions != "srt,nfo" {
+		t.Fatalf("unexpected config: %+v", current)
+	}
+
+	current.FileDate = FileDateCinemas
+
+	updated, err := client.UpdateMediaManagementConfig(current)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updated.FileDate != FileDateCinemas {
+		t.Errorf("expected the updated config to be returned, got %+v", updated)
+	}
+
+	invalid := []func(*MediaManagementConfig){
+		func(c *MediaManagementConfig) { c.FileDate = "tomorrow" },
+		func(c *MediaManagementConfig) { c.RescanAfterRefresh = "sometimes" },
+		func(c *MediaManagementConfig) { c.DownloadPropersAndRepacks = "maybe" },
+		func(c *MediaManagementConfig) { c.RecycleBinCleanupDays = -1 },
+		func(c *MediaManagementConfig) { c.MinimumFreeSpaceWhenImporting = -1 },
+		func(c *MediaManagementConfig) { c.SetPermissionsLinux, c.ChmodFolder = true, "rwxr-xr-x" },
+		func(c *MediaManagementConfig) { c.ExtraFileExtensions = "" },
+	}
+
+	for i, set := range invalid {
+		config := current
+		set(&config)
+
+		if _, err := client.UpdateMediaManagementConfig(config); err == nil {
+			t.Errorf("expected invalid config %d to be rejected", i)
+		}
+	}
+
+	if puts != 1 {
+		t.Errorf("expected invalid configs to be rejected before the request, got %d puts", puts)
+	}
+}
@@ -0,0 +1,188 @@
+package radarr
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// metadata consumer implementations
+const (
+	// MetadataKodi kodi (xbmc) nfo and images
+	MetadataKodi = "XbmcMetadata"
+	// MetadataEmby emby (media browser) xml
+	MetadataEmby = "MediaBrowserMetadata"
+	// MetadataRoksbox roksbox xml and images
+	MetadataRoksbox = "RoksboxMetadata"
+	// MetadataWDTV wdtv xml and images
+	MetadataWDTV = "WdtvMetadata"
+)
+
+// MetadataConsumer writes nfo/xml files and images next to movies for a media center
+type MetadataConsumer struct {
+	ID                 int     `json:"id"`
+	Name               string  `json:"name"`
+	Enable             bool    `json:"enable"`
+	Implementation     string  `json:"implementation"`
+	ImplementationName string  `json:"implementationName"`
+	ConfigContract     string  `json:"configContract"`
+	InfoLink           string  `json:"infoLink"`
+	Tags               []int   `json:"tags"`
+	Fields             []Field `json:"fields"`
+
+	Unknown
+}
+
+// UnmarshalJSON decodes the metadata consumer and keeps the fields it doesn't model in Extra
+func (m *MetadataConsumer) UnmarshalJSON(data []byte) error {
+	type plain MetadataConsumer
+
+	return unmarshalUnknown(data, (*plain)(m), &m.Unknown)
+}
+
+// MarshalJSON encodes the metadata consumer with Extra merged back in
+func (m MetadataConsumer) MarshalJSON() ([]byte, error) {
+	type plain MetadataConsumer
+
+	return marshalUnknown(plain(m), m.Unknown)
+}
+
+// MetadataSettings the typed fields of a metadata consumer. Not every
+// consumer supports every setting, e.g. only kodi has UseMovieNfo
+type MetadataSettings struct {
+	// MovieMetadata write the nfo/xml file
+	MovieMetadata bool
+	// MovieMetadataURL write the tmdb/imdb url into the nfo
+	MovieMetadataURL bool
+	// MovieImages save the poster and fanart
+	MovieImages bool
+	// UseMovieNfo name the nfo movie.nfo instead of after the movie file
+	UseMovieNfo bool
+	// AddCollectionName add the collection to the nfo
+	AddCollectionName bool
+}
+
+// metadataFields maps MetadataSettings to radarr's field names
+func metadataFields(settings *MetadataSettings) map[string]*bool {
+	return map[string]*bool{
+		"movieMetadata":     &settings.MovieMetadata,
+		"movieMetadataURL":  &settings.MovieMetadataURL,
+		"movieImages":       &settings.MovieImages,
+		"useMovieNfo":       &settings.UseMovieNfo,
+		"addCollectionName": &settings.AddCollectionName,
+	}
+}
+
+// Settings reads the typed settings from the consumer's fields
+func (m MetadataConsumer) Settings() MetadataSettings {
+	var settings MetadataSettings
+
+	fields := metadataFields(&settings)
+
+	for _, field := range m.Fields {
+		if setting, ok := fields[field.Name]; ok {
+			value, _ := field.Value.(bool)
+			*setting = value
+		}
+	}
+
+	return settings
+}
+
+// SetSettings writes the typed settings into the consumer's fields --
+// settings the consumer doesn't support are ignored
+func (m *MetadataConsumer) SetSettings(settings MetadataSettings) {
+	fields := metadataFields(&settings)
+
+	// copy so consumers sharing the slice aren't changed
+	m.Fields = append(m.Fields[:0:0], m.Fields...)
+
+	for i, field := range m.Fields {
+		if setting, ok := fields[field.Name]; ok {
+			m.Fields[i].Value = *setting
+		}
+	}
+}
+
+// GetMetadataConsumers returns every metadata consumer
+func (c Client) GetMetadataConsumers() ([]MetadataConsumer, error) {
+	const endpoint = "/api/metadata"
+	var consumers []MetadataConsumer
+
+	resp, err := c.get(endpoint, nil)
+
+	if err != nil {
+		return consumers, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return consumers, errors.New(resp.Status)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&consumers)
+
+	return consumers, err
+}
+
+// GetMetadataConsumer returns a metadata consumer by its id
+func (c Client) GetMetadataConsumer(id int) (MetadataConsumer, error) {
+	const endpoint = "/api/metadata/%d"
+	var consumer MetadataConsumer
+
+	resp, err := c.get(fmt.Sprintf(endpoint, id), nil)
+
+	if err != nil {
+		return consumer, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return consumer, errors.New(resp.Status)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&consumer)
+
+	return consumer, err
+}
+
+// UpdateMetadataConsumer saves a metadata consumer, e.g. after changing
+// Enable or calling SetSettings
+func (c Client) UpdateMetadataConsumer(consumer MetadataConsumer) (MetadataConsumer, error) {
+	const endpoint = "/api/metadata/%d"
+
+	if consumer.ID == 0 {
+		return consumer, errors.New("id is required")
+	}
+
+	if consumer.Implementation == "" || consumer.ConfigContract == "" {
+		return consumer, errors.New("implementation and config contract are required")
+	}
+
+	requestPayload, err := json.Marshal(consumer)
+
+	if err != nil {
+		return consumer, err
+	}
+
+	resp, err := c.put(fmt.Sprintf(endpoint, consumer.ID), requestPayload)
+
+	if err != nil {
+		return consumer, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusAccepted && resp.StatusCode != http.StatusOK {
+		return consumer, errors.New(resp.Status)
+	}
+
+	var updated MetadataConsumer
+
+	err = json.NewDecoder(resp.Body).Decode(&updated)
+
+	return updated, err
+}
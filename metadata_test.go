@@ -0,0 +1,106 @@
+package radarr
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const kodiConsumer = `{"id":1,"name":"Kodi (XBMC) / Emby","enable":true,"implementation":"XbmcMetadata","implementationName":"Kodi (XBMC) / Emby","configContract":"XbmcMetadataSettings","infoLink":"https://wiki.servarr.com/radarr/supported#xbmcmetadata","tags":[],"fields":[{"order":0,"name":"movieMetadata","label":"Movie Metadata","value":true,"type":"checkbox","advanced":false},{"order":1,"name":"movieMetadataURL","label":"Movie Metadata URL","value":false,"type":"checkbox","advanced":false},{"order":2,"name":"movieImages","label":"Movie Images","value":true,"type":"checkbox","advanced":false},{"order":3,"name":"useMovieNfo","label":"Use Movie.nfo","value":false,"type":"checkbox","advanced":false}]}`
+
+func TestMetadataConsumers(t *testing.T) {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/api/metadata", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[` + kodiConsumer + `]`))
+	})
+
+	mux.HandleFunc("/api/metadata/1", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" {
+			w.Write([]byte(kodiConsumer))
+			return
+		}
+
+		var consumer MetadataConsumer
+
+		if err := json.NewDecoder(r.Body).Decode(&consumer); err != nil {
+			t.Error(err)
+		}
+
+		settings := consumer.Settings()
+
+		if consumer.Enable || !settings.UseMovieNfo || settings.MovieImages {
+			t.Errorf("unexpected update: %+v", settings)
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+		json.NewEncoder(w).Encode(consumer)
+	})
+
+	mux.HandleFunc("/api/metadata/2", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := New(server.URL, "abc123")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	consumers, err := client.GetMetadataConsumers()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(consumers) != 1 || consumers[0].Implementation != MetadataKodi || len(consumers[0].Fields) != 4 {
+		t.Fatalf("unexpected consumers: %+v", consumers)
+	}
+
+	consumer, err := client.GetMetadataConsumer(1)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	settings := consumer.Settings()
+
+	if !settings.MovieMetadata || settings.MovieMetadataURL || !settings.MovieImages || settings.UseMovieNfo || settings.AddCollectionName {
+		t.Errorf("unexpected settings: %+v", settings)
+	}
+
+	original := consumers[0].Fields
+
+	consumer.Enable = false
+	settings.UseMovieNfo = true
+	settings.MovieImages = false
+	// kodi doesn't have this field so it is ignored
+	settings.AddCollectionName = true
+	consumer.SetSettings(settings)
+
+	if len(consumer.Fields) != 4 || original[3].Value != false {
+		t.Errorf("expected SetSettings to only change the consumer's own fields, got %+v", consumer.Fields)
+	}
+
+	updated, err := client.UpdateMetadataConsumer(consumer)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !updated.Settings().UseMovieNfo {
+		t.Errorf("expected the updated consumer to be returned, got %+v", updated)
+	}
+
+	if _, err := client.UpdateMetadataConsumer(MetadataConsumer{Implementation: MetadataKodi}); err == nil {
+		t.Error("expected a consumer without an id to return an error")
+	}
+
+	if _, err := client.GetMetadataConsumer(2); err == nil {
+		t.Error("expected a non-200 status to return an error")
+	}
+}
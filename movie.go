@@ -9,47 +9,118 @@ import (
 	"strconv"
 )
 
+// MovieStatus where a movie is in its release cycle
+type MovieStatus string
+
+const (
+	// StatusTBA no release date is known yet
+	StatusTBA MovieStatus = "tba"
+	// StatusAnnounced announced but not in cinemas yet
+	StatusAnnounced MovieStatus = "announced"
+	// StatusInCinemas showing in cinemas
+	StatusInCinemas MovieStatus = "inCinemas"
+	// StatusReleased physically or digitally released
+	StatusReleased MovieStatus = "released"
+	// StatusDeleted removed from tmdb
+	StatusDeleted MovieStatus = "deleted"
+)
+
+// Valid whether the status is one radarr knows
+func (s MovieStatus) Valid() bool {
+	switch s {
+	case StatusTBA, StatusAnnounced, StatusInCinemas, StatusReleased, StatusDeleted:
+		return true
+	}
+
+	return false
+}
+
+func (s MovieStatus) String() string {
+	return string(s)
+}
+
+// Availability when radarr considers a movie available and starts searching for it
+type Availability string
+
+const (
+	// AvailabilityTBA as soon as the movie is added
+	AvailabilityTBA Availability = "tba"
+	// AvailabilityAnnounced once the movie is announced
+	AvailabilityAnnounced Availability = "announced"
+	// AvailabilityInCinemas once the movie is in cinemas
+	AvailabilityInCinemas Availability = "inCinemas"
+	// AvailabilityReleased once the movie is physically or digitally released
+	AvailabilityReleased Availability = "released"
+	// AvailabilityPreDB once a release shows up on predb
+	AvailabilityPreDB Availability = "preDB"
+)
+
+// Valid whether the availability is one radarr knows
+func (a Availability) Valid() bool {
+	switch a {
+	case AvailabilityTBA, AvailabilityAnnounced, AvailabilityInCinemas, AvailabilityReleased, AvailabilityPreDB:
+		return true
+	}
+
+	return false
+}
+
+func (a Availability) String() string {
+	return string(a)
+}
+
+// PathState whether a movie's folder follows the naming config
+type PathState string
+
+const (
+	// PathStatic the folder was set by hand and isn't renamed
+	PathStatic PathState = "static"
+	// PathDynamic the folder is named by the naming config
+	PathDynamic PathState = "dynamic"
+)
+
+// Valid whether the path state is one radarr knows
+func (p PathState) Valid() bool {
+	return p == PathStatic || p == PathDynamic
+}
+
+func (p PathState) String() string {
+	return string(p)
+}
+
 // Movie ...
 type Movie struct {
-	Added      string `json:"added"`
+	Added      Date `json:"added"`
 	AddOptions struct {
 		IgnoreEpisodesWithFiles    bool `json:"ignoreEpisodesWithFiles"`
 		IgnoreEpisodesWithoutFiles bool `json:"ignoreEpisodesWithoutFiles"`
 		SearchForMovie             bool `json:"searchForMovie"`
 	} `json:"addOptions"`
-	AlternativeTitles []struct {
-		Language   string `json:"language"`
-		MovieID    int    `json:"movieId"`
-		SourceID   int    `json:"sourceId"`
-		SourceType string `json:"sourceType"`
-		Title      string `json:"title"`
-		VoteCount  int    `json:"voteCount"`
-		Votes      int    `json:"votes"`
-	} `json:"alternativeTitles"`
-	CleanTitle       string   `json:"cleanTitle"`
-	Deleted          bool     `json:"deleted"`
-	Downloaded       bool     `json:"downloaded"`
-	ErrorMessage     string   `json:"error"`
-	EpisodeCount     int      `json:"episodeCount"`
-	EpisodeFileCount int      `json:"episodeFileCount"`
-	FolderName       string   `json:"folderName"`
-	Genres           []string `json:"genres"`
-	HasFile          bool     `json:"hasFile"`
-	ID               int      `json:"id"`
-	ImdbID           string   `json:"imdbId"`
-	Images           []struct {
+	AlternativeTitles []AlternativeTitle `json:"alternativeTitles"`
+	CleanTitle        string             `json:"cleanTitle"`
+	Deleted           bool               `json:"deleted"`
+	Downloaded        bool               `json:"downloaded"`
+	ErrorMessage      string             `json:"error"`
+	EpisodeCount      int                `json:"episodeCount"`
+	EpisodeFileCount  int                `json:"episodeFileCount"`
+	FolderName        string             `json:"folderName"`
+	Genres            []string           `json:"genres"`
+	HasFile           bool               `json:"hasFile"`
+	ID                int                `json:"id"`
+	ImdbID            string             `json:"imdbId"`
+	Images            []struct {
 		CoverType string `json:"coverType"`
 		URL       string `json:"url"`
 	} `json:"images"`
-	InCinemas           string `json:"inCinemas"`
-	IsAvailable         bool   `json:"isAvailable"`
-	IsExisting          bool   `json:"isExisting"`
-	MinimumAvailability string `json:"minimumAvailability"`
-	Monitored           bool   `json:"monitored"`
-	Overview            string `json:"overview"`
-	Path                string `json:"path"`
-	PathState           string `json:"pathState"`
-	PhysicalRelease     string `json:"physicalRelease"`
+	InCinemas           Date         `json:"inCinemas"`
+	IsAvailable         bool         `json:"isAvailable"`
+	IsExisting          bool         `json:"isExisting"`
+	MinimumAvailability Availability `json:"minimumAvailability"`
+	Monitored           bool         `json:"monitored"`
+	Overview            string       `json:"overview"`
+	Path                string       `json:"path"`
+	PathState           PathState    `json:"pathState"`
+	PhysicalRelease     Date         `json:"physicalRelease"`
 
 	ProfileID        int `json:"profileId"`
 	QualityProfileID int `json:"qualityProfileId"`
@@ -57,22 +128,38 @@ type Movie struct {
 		Value float64 `json:"value"`
 		Votes int     `json:"votes"`
 	} `json:"ratings"`
-	RemotePoster          string   `json:"remotePoster"`
-	RootFolderPath        string   `json:"rootFolderPath"`
-	Runtime               int      `json:"runtime"`
-	SecondaryYearSourceID int      `json:"secondaryYearSourceId"`
-	SizeOnDisk            int      `json:"sizeOnDisk"`
-	SortTitle             string   `json:"sortTitle"`
-	Saved                 bool     `json:"saved"`
-	Status                string   `json:"status"`
-	Studio                string   `json:"studio"`
-	Tags                  []string `json:"tags"`
-	Title                 string   `json:"title"`
-	TitleSlug             string   `json:"titleSlug"`
-	TmdbID                int      `json:"tmdbId"`
-	Year                  int      `json:"year"`
-	YouTubeTrailerID      string   `json:"youTubeTrailerId"`
-	Website               string   `json:"website"`
+	RemotePoster          string      `json:"remotePoster"`
+	RootFolderPath        string      `json:"rootFolderPath"`
+	Runtime               int         `json:"runtime"`
+	SecondaryYearSourceID int         `json:"secondaryYearSourceId"`
+	SizeOnDisk            int         `json:"sizeOnDisk"`
+	SortTitle             string      `json:"sortTitle"`
+	Saved                 bool        `json:"saved"`
+	Status                MovieStatus `json:"status"`
+	Studio                string      `json:"studio"`
+	Tags                  []string    `json:"tags"`
+	Title                 string      `json:"title"`
+	TitleSlug             string      `json:"titleSlug"`
+	TmdbID                int         `json:"tmdbId"`
+	Year                  int         `json:"year"`
+	YouTubeTrailerID      string      `json:"youTubeTrailerId"`
+	Website               string      `json:"website"`
+
+	Unknown
+}
+
+// UnmarshalJSON decodes the movie and keeps the fields it doesn't model in Extra
+func (m *Movie) UnmarshalJSON(data []byte) error {
+	type plain Movie
+
+	return unmarshalUnknown(data, (*plain)(m), &m.Unknown)
+}
+
+// MarshalJSON encodes the movie with Extra merged back in
+func (m Movie) MarshalJSON() ([]byte, error) {
+	type plain Movie
+
+	return marshalUnknown(plain(m), m.Unknown)
 }
 
 // Library movies in wanted list and recognized by radarr
@@ -120,6 +207,18 @@ func (c Client) AddMovie(movie Movie) []error {
 		return []error{errors.New("either a path or rootFolderPath is required")}
 	}
 
+	if movie.MinimumAvailability != "" && !movie.MinimumAvailability.Valid() {
+		return []error{fmt.Errorf("unknown minimum availability: %q", movie.MinimumAvailability)}
+	}
+
+	if movie.Status != "" && !movie.Status.Valid() {
+		return []error{fmt.Errorf("unknown status: %q", movie.Status)}
+	}
+
+	if movie.PathState != "" && !movie.PathState.Valid() {
+		return []error{fmt.Errorf("unknown path state: %q", movie.PathState)}
+	}
+
 	requestPayload, err := json.Marshal(movie)
 
 	if err != nil {
@@ -154,7 +253,7 @@ func (c Client) AddMovie(movie Movie) []error {
 			case ErrorPathAlreadyConfigured.Error():
 				newErr = ErrorPathAlreadyConfigured
 			default:
-				newErr = fmt.Errorf(err.Message)
+				newErr = errors.New(err.Message)
 			}
 
 			errs = append(errs, newErr)
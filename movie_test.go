@@ -0,0 +1,99 @@
+package radarr
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMovieDates(t *testing.T) {
+	data := `{"added":"2018-03-14T03:55:12.7155480Z","inCinemas":"2018-01-19T00:00:00","physicalRelease":"","status":"released","minimumAvailability":"preDB","pathState":"static"}`
+
+	var movie Movie
+
+	if err := json.Unmarshal([]byte(data), &movie); err != nil {
+		t.Fatal(err)
+	}
+
+	if !movie.Added.Equal(time.Date(2018, 3, 14, 3, 55, 12, 715548000, time.UTC)) {
+		t.Errorf("unexpected added date: %v", movie.Added)
+	}
+
+	if !movie.InCinemas.Equal(time.Date(2018, 1, 19, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected in cinemas date: %v", movie.InCinemas)
+	}
+
+	if !movie.PhysicalRelease.IsZero() {
+		t.Errorf("expected an empty date to be zero, got %v", movie.PhysicalRelease)
+	}
+
+	if movie.Status != StatusReleased || movie.MinimumAvailability != AvailabilityPreDB || movie.PathState != PathStatic {
+		t.Errorf("unexpected enums: %s %s %s", movie.Status, movie.MinimumAvailability, movie.PathState)
+	}
+
+	encoded, err := json.Marshal(movie)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, field := range []string{`"added":"2018-03-14T03:55:12.7155480Z"`, `"inCinemas":"2018-01-19T00:00:00"`, `"physicalRelease":""`} {
+		if !strings.Contains(string(encoded), field) {
+			t.Errorf("expected unchanged dates to be written back as received, missing %s", field)
+		}
+	}
+
+	movie.PhysicalRelease = NewDate(time.Date(2018, 5, 1, 0, 0, 0, 0, time.UTC))
+
+	encoded, _ = json.Marshal(movie)
+
+	if !strings.Contains(string(encoded), `"physicalRelease":"2018-05-01T00:00:00Z"`) {
+		t.Errorf("expected a changed date to be written in rfc 3339, got %s", encoded)
+	}
+
+	if err := json.Unmarshal([]byte(`{"added":"last tuesday"}`), &movie); err == nil {
+		t.Error("expected an unknown date format to return an error")
+	}
+
+	if err := json.Unmarshal([]byte(`{"added":null}`), &movie); err != nil || !movie.Added.IsZero() {
+		t.Errorf("expected a null date to be zero, got %v %v", movie.Added, err)
+	}
+}
+
+func TestAddMovieRejectsUnknownEnums(t *testing.T) {
+	client, err := New("http://127.0.0.1:1", "abc123")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	movie := Movie{
+		Title:            "The Matrix",
+		TitleSlug:        "the-matrix-603",
+		QualityProfileID: 1,
+		TmdbID:           603,
+		RootFolderPath:   "/movies",
+		Images: []struct {
+			CoverType string `json:"coverType"`
+			URL       string `json:"url"`
+		}{{CoverType: "poster", URL: "/poster.jpg"}},
+	}
+
+	unknown := []func(*Movie){
+		func(m *Movie) { m.MinimumAvailability = "whenever" },
+		func(m *Movie) { m.Status = "lost" },
+		func(m *Movie) { m.PathState = "floating" },
+	}
+
+	for _, set := range unknown {
+		invalid := movie
+		set(&invalid)
+
+		errs := client.AddMovie(invalid)
+
+		if len(errs) != 1 || !strings.HasPrefix(errs[0].Error(), "unknown") {
+			t.Errorf("expected an unknown enum to be rejected before the request, got %v", errs)
+		}
+	}
+}
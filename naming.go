@@ -0,0 +1,283 @@
+package radarr
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ColonReplacement how radarr replaces colons in file and folder names
+type ColonReplacement string
+
+const (
+	// ColonDelete removes colons
+	ColonDelete ColonReplacement = "delete"
+	// ColonDash replaces colons with a dash
+	ColonDash ColonReplacement = "dash"
+	// ColonSpaceDash replaces colons with a space and a dash
+	ColonSpaceDash ColonReplacement = "spaceDash"
+	// ColonSpaceDashSpace replaces colons with a dash surrounded by spaces
+	ColonSpaceDashSpace ColonReplacement = "spaceDashSpace"
+	// ColonSmart uses a dash or a spaced dash depending on the colon's position
+	ColonSmart ColonReplacement = "smart"
+)
+
+// NamingConfig how radarr names movie folders and files
+type NamingConfig struct {
+	ID                       int              `json:"id"`
+	RenameMovies             bool             `json:"renameMovies"`
+	ReplaceIllegalCharacters bool             `json:"replaceIllegalCharacters"`
+	ColonReplacementFormat   ColonReplacement `json:"colonReplacementFormat"`
+	StandardMovieFormat      string           `json:"standardMovieFormat"`
+	MovieFolderFormat        string           `json:"movieFolderFormat"`
+
+	Unknown
+}
+
+// UnmarshalJSON decodes the naming config and keeps the fields it doesn't model in Extra
+func (n *NamingConfig) UnmarshalJSON(data []byte) error {
+	type plain NamingConfig
+
+	return unmarshalUnknown(data, (*plain)(n), &n.Unknown)
+}
+
+// MarshalJSON encodes the naming config with Extra merged back in
+func (n NamingConfig) MarshalJSON() ([]byte, error) {
+	type plain NamingConfig
+
+	return marshalUnknown(plain(n), n.Unknown)
+}
+
+// NamingInfo values for naming tokens that describe a movie file rather than
+// the movie itself
+type NamingInfo struct {
+	// Quality e.g. Bluray-1080p
+	Quality string
+	// Proper adds Proper to {Quality Full}
+	Proper bool
+	// Edition e.g. Director's Cut
+	Edition      string
+	ReleaseGroup string
+}
+
+// GetNamingConfig returns radarr's naming settings
+func (c Client) GetNamingConfig() (NamingConfig, error) {
+	const endpoint = "/api/config/naming"
+	var config NamingConfig
+
+	resp, err := c.get(endpoint, nil)
+
+	if err != nil {
+		return config, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return config, errors.New(resp.Status)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&config)
+
+	return config, err
+}
+
+// UpdateNamingConfig saves radarr's naming settings
+func (c Client) UpdateNamingConfig(config NamingConfig) (NamingConfig, error) {
+	const endpoint = "/api/config/naming"
+
+	if config.RenameMovies && config.StandardMovieFormat == "" {
+		return config, errors.New("standard movie format is required when renaming movies")
+	}
+
+	if config.MovieFolderFormat == "" {
+		return config, errors.New("movie folder format is required")
+	}
+
+	switch config.ColonReplacementFormat {
+	case "", ColonDelete, ColonDash, ColonSpaceDash, ColonSpaceDashSpace, ColonSmart:
+	default:
+		return config, errors.New("unknown colon replacement format: " + string(config.ColonReplacementFormat))
+	}
+
+	requestPayload, err := json.Marshal(config)
+
+	if err != nil {
+		return config, err
+	}
+
+	resp, err := c.put(endpoint, requestPayload)
+
+	if err != nil {
+		return config, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusAccepted && resp.StatusCode != http.StatusOK {
+		return config, errors.New(resp.Status)
+	}
+
+	var updated NamingConfig
+
+	err = json.NewDecoder(resp.Body).Decode(&updated)
+
+	return updated, err
+}
+
+// FolderName previews the folder radarr would create for movie, e.g. to set
+// Movie.Path before calling AddMovie
+func (n NamingConfig) FolderName(movie Movie) string {
+	return n.clean(RenderNaming(n.MovieFolderFormat, movie, NamingInfo{}))
+}
+
+// FileName previews the name radarr would give a movie file, without extension
+func (n NamingConfig) FileName(movie Movie, info NamingInfo) string {
+	return n.clean(RenderNaming(n.StandardMovieFormat, movie, info))
+}
+
+// characters windows and samba can't handle and radarr's replacements for them
+var illegalCharacters = strings.NewReplacer(`\`, "+", "/", "+", "<", "", ">", "", "?", "!", "*", "-", "|", "", `"`, "")
+var illegalCharactersRemoved = strings.NewReplacer(`\`, "", "/", "", "<", "", ">", "", "?", "", "*", "", "|", "", `"`, "")
+
+func (n NamingConfig) clean(name string) string {
+	switch n.ColonReplacementFormat {
+	case ColonDash:
+		name = strings.ReplaceAll(name, ":", "-")
+	case ColonSpaceDash:
+		name = strings.ReplaceAll(name, ":", " -")
+	case ColonSpaceDashSpace:
+		name = strings.ReplaceAll(name, ":", " - ")
+	case ColonSmart:
+		name = strings.ReplaceAll(name, ": ", " - ")
+		name = strings.ReplaceAll(name, ":", "-")
+	default:
+		name = strings.ReplaceAll(name, ":", "")
+	}
+
+	if n.ReplaceIllegalCharacters {
+		name = illegalCharacters.Replace(name)
+	} else {
+		name = illegalCharactersRemoved.Replace(name)
+	}
+
+	return strings.TrimSpace(repeatSpacesRe.ReplaceAllString(name, " "))
+}
+
+// namingTokenRe matches naming tokens, e.g. {Movie Title}, {Movie.Title},
+// {[Quality Full]} or {-Release Group}
+var (
+	namingTokenRe  = regexp.MustCompile(`\{([- ._\[(]*)([A-Za-z0-9]+(?:[- ._][A-Za-z0-9]+)*)([- ._)\]]*)\}`)
+	emptyBracesRe  = regexp.MustCompile(`\(\s*\)|\[\s*\]`)
+	repeatSpacesRe = regexp.MustCompile(` {2,}`)
+	cleanTitleRe   = regexp.MustCompile(`[^\p{L}\p{N}\s]`)
+)
+
+// RenderNaming expands the naming tokens in format the way radarr does.
+// The separator used in a token, e.g. {Movie.Title}, replaces the spaces of
+// its value and the case of the token, e.g. {MOVIE TITLE}, is applied to the
+// value. Unknown tokens are left untouched
+func RenderNaming(format string, movie Movie, info NamingInfo) string {
+	rendered := namingTokenRe.ReplaceAllStringFunc(format, func(token string) string {
+		parts := namingTokenRe.FindStringSubmatch(token)
+		prefix, name, suffix := parts[1], parts[2], parts[3]
+
+		separator := " "
+
+		if i := strings.IndexAny(name, "-._ "); i >= 0 {
+			separator = name[i : i+1]
+		}
+
+		key := strings.ToLower(strings.NewReplacer("-", " ", ".", " ", "_", " ").Replace(name))
+
+		value, ok := namingValue(key, movie, info)
+
+		if !ok {
+			return token
+		}
+
+		if value == "" {
+			return ""
+		}
+
+		if separator != " " {
+			value = strings.ReplaceAll(value, " ", separator)
+		}
+
+		switch {
+		case name == strings.ToUpper(name) && name != strings.ToLower(name):
+			value = strings.ToUpper(value)
+		case name == strings.ToLower(name) && name != strings.ToUpper(name):
+			value = strings.ToLower(value)
+		}
+
+		return prefix + value + suffix
+	})
+
+	rendered = emptyBracesRe.ReplaceAllString(rendered, "")
+	rendered = repeatSpacesRe.ReplaceAllString(rendered, " ")
+
+	return strings.TrimSpace(rendered)
+}
+
+func namingValue(key string, movie Movie, info NamingInfo) (string, bool) {
+	switch key {
+	case "movie title":
+		return movie.Title, true
+	case "movie cleantitle":
+		return strings.Join(strings.Fields(cleanTitleRe.ReplaceAllString(movie.Title, "")), " "), true
+	case "movie titlethe":
+		return titleThe(movie.Title), true
+	case "movie titlefirstcharacter":
+		for _, r := range titleThe(movie.Title) {
+			if unicode.IsLetter(r) || unicode.IsNumber(r) {
+				return strings.ToUpper(string(r)), true
+			}
+		}
+
+		return "", true
+	case "release year":
+		if movie.Year == 0 {
+			return "", true
+		}
+
+		return strconv.Itoa(movie.Year), true
+	case "imdb id":
+		return movie.ImdbID, true
+	case "tmdb id":
+		if movie.TmdbID == 0 {
+			return "", true
+		}
+
+		return strconv.Itoa(movie.TmdbID), true
+	case "quality full":
+		if info.Proper && info.Quality != "" {
+			return info.Quality + " Proper", true
+		}
+
+		return info.Quality, true
+	case "quality title":
+		return info.Quality, true
+	case "edition tags":
+		return info.Edition, true
+	case "release group":
+		return info.ReleaseGroup, true
+	}
+
+	return "", false
+}
+
+// titleThe moves a leading article to the end, e.g. "Matrix, The"
+func titleThe(title string) string {
+	for _, article := range []string{"The ", "A ", "An "} {
+		if strings.HasPrefix(title, article) && len(title) > len(article) {
+			return title[len(article):] + ", " + strings.TrimSpace(article)
+		}
+	}
+
+	return title
+}
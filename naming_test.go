@@ -0,0 +1,70 @@
+package radarr
+
+import "testing"
+
+func TestRenderNaming(t *testing.T) {
+	movie := Movie{
+		Title:  "Mission: Impossible - Dead Reckoning Part One",
+		Year:   2023,
+		TmdbID: 575264,
+		ImdbID: "tt9603212",
+	}
+
+	info := NamingInfo{Quality: "WEBDL-2160p", Proper: true, Edition: "IMAX", ReleaseGroup: "FLUX"}
+
+	tests := []struct {
+		format   string
+		expected string
+	}{
+		{"{Movie Title} ({Release Year})", "Mission: Impossible - Dead Reckoning Part One (2023)"},
+		{"{Movie.CleanTitle}.{Release.Year}", "Mission.Impossible.Dead.Reckoning.Part.One.2023"},
+		{"{MOVIE TITLE}", "MISSION: IMPOSSIBLE - DEAD RECKONING PART ONE"},
+		{"{movie title}", "mission: impossible - dead reckoning part one"},
+		{"{Movie Title} {Quality Full} {[Edition Tags]}{-Release Group}", "Mission: Impossible - Dead Reckoning Part One WEBDL-2160p Proper [IMAX]-FLUX"},
+		{"{Movie Title} [imdb-{IMDb Id}][tmdb-{TMDb Id}]", "Mission: Impossible - Dead Reckoning Part One [imdb-tt9603212][tmdb-575264]"},
+		{"{Movie Title} {Unknown Token}", "Mission: Impossible - Dead Reckoning Part One {Unknown Token}"},
+	}
+
+	for _, test := range tests {
+		if rendered := RenderNaming(test.format, movie, info); rendered != test.expected {
+			t.Errorf("%s\nexpected\n\t%s\ngot\n\t%s", test.format, test.expected, rendered)
+		}
+	}
+
+	// empty tokens drop their brackets
+	if rendered := RenderNaming("{Movie Title} {[Edition Tags]} ({Release Year})", Movie{Title: "The Matrix"}, NamingInfo{}); rendered != "The Matrix" {
+		t.Errorf("expected empty tokens to be removed, got %s", rendered)
+	}
+
+	if rendered := RenderNaming("{Movie TitleThe}", Movie{Title: "The Matrix"}, NamingInfo{}); rendered != "Matrix, The" {
+		t.Errorf("expected Matrix, The, got %s", rendered)
+	}
+}
+
+func TestNamingConfigFolderName(t *testing.T) {
+	movie := Movie{Title: "Mission: Impossible", Year: 1996}
+
+	tests := []struct {
+		colon    ColonReplacement
+		expected string
+	}{
+		{ColonDelete, "Mission Impossible (1996)"},
+		{ColonDash, "Mission- Impossible (1996)"},
+		{ColonSpaceDashSpace, "Mission - Impossible (1996)"},
+		{ColonSmart, "Mission - Impossible (1996)"},
+	}
+
+	for _, test := range tests {
+		config := NamingConfig{MovieFolderFormat: "{Movie Title} ({Release Year})", ColonReplacementFormat: test.colon}
+
+		if folder := config.FolderName(movie); folder != test.expected {
+			t.Errorf("%s: expected %s, got %s", test.colon, test.expected, folder)
+		}
+	}
+
+	config := NamingConfig{MovieFolderFormat: "{Movie Title}", ReplaceIllegalCharacters: true}
+
+	if folder := config.FolderName(Movie{Title: "What If...?"}); folder != "What If...!" {
+		t.Errorf("expected illegal characters to be replaced, got %s", folder)
+	}
+}
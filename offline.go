@@ -0,0 +1,404 @@
+package radarr
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// SearchResult a library movie matched by an offline search
+type SearchResult struct {
+	Movie Movie
+	// Score how well the movie matched, from 0 to 1 where 1 is an exact title match
+	Score float64
+	// MatchedTitle the title, sort title, clean title or alternative title that matched best
+	MatchedTitle string
+}
+
+// OfflineSearchOptions change how SearchOffline and MovieIndex.Search match
+type OfflineSearchOptions struct {
+	// Year only returns movies released that year when set
+	Year int
+	// Limit the number of results, 0 returns every match
+	Limit int
+	// MinScore drops matches scoring below it -- defaults to 0.6
+	MinScore float64
+}
+
+// MovieIndex an in-memory index of the library for repeated offline searches
+// without refetching every movie. It is safe for concurrent use
+type MovieIndex struct {
+	mu      sync.RWMutex
+	entries []indexEntry
+}
+
+type indexEntry struct {
+	movie  Movie
+	titles []indexTitle
+}
+
+type indexTitle struct {
+	original   string
+	normalized string
+	compact    string
+	// weight alternative titles rank slightly below the movie's own titles
+	weight float64
+}
+
+// alternativeWeight how much an alternative title match counts compared to the title
+const alternativeWeight = 0.95
+
+// SearchOffline searches the library for movies matching query. Matching
+// ignores case, accents and punctuation, tolerates typos and also considers
+// the sort, clean and alternative titles. Results are ranked best first.
+// Use IndexLibrary instead when searching repeatedly
+func (c Client) SearchOffline(query string, options OfflineSearchOptions) ([]SearchResult, error) {
+	index, err := c.IndexLibrary()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return index.Search(query, options)
+}
+
+// IndexLibrary fetches the library once and returns an index to search it
+func (c Client) IndexLibrary() (*MovieIndex, error) {
+	movies, err := c.GetMovies(GetMovieOptions{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return NewMovieIndex(movies), nil
+}
+
+// NewMovieIndex creates an index of movies
+func NewMovieIndex(movies []Movie) *MovieIndex {
+	index := &MovieIndex{}
+
+	index.Reset(movies)
+
+	return index
+}
+
+// Reset replaces the indexed movies, e.g. after refetching the library
+func (i *MovieIndex) Reset(movies []Movie) {
+	entries := make([]indexEntry, 0, len(movies))
+
+	for _, movie := range movies {
+		entry := indexEntry{movie: movie}
+
+		add := func(title string, weight float64) {
+			normalized := normalizeTitle(title)
+
+			if normalized == "" {
+				return
+			}
+
+			entry.titles = append(entry.titles, indexTitle{
+				original:   title,
+				normalized: normalized,
+				compact:    strings.ReplaceAll(normalized, " ", ""),
+				weight:     weight,
+			})
+		}
+
+		add(movie.Title, 1)
+		add(movie.SortTitle, 1)
+		add(movie.CleanTitle, 1)
+
+		for _, alternative := range movie.AlternativeTitles {
+			add(alternative.Title, alternativeWeight)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	i.mu.Lock()
+	i.entries = entries
+	i.mu.Unlock()
+}
+
+// Len the number of indexed movies
+func (i *MovieIndex) Len() int {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	return len(i.entries)
+}
+
+// ByTmdbID returns the indexed movie with a tmdb id
+func (i *MovieIndex) ByTmdbID(tmdbID int) (Movie, bool) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	for _, entry := range i.entries {
+		if entry.movie.TmdbID == tmdbID {
+			return entry.movie, true
+		}
+	}
+
+	return Movie{}, false
+}
+
+// ByImdbID returns the indexed movie with an imdb id, e.g. tt0133093
+func (i *MovieIndex) ByImdbID(imdbID string) (Movie, bool) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	for _, entry := range i.entries {
+		if strings.EqualFold(entry.movie.ImdbID, imdbID) {
+			return entry.movie, true
+		}
+	}
+
+	return Movie{}, false
+}
+
+// Search returns the indexed movies matching query, best first
+func (i *MovieIndex) Search(query string, options OfflineSearchOptions) ([]SearchResult, error) {
+	normalized := normalizeTitle(query)
+
+	if normalized == "" {
+		return nil, errors.New("query is required")
+	}
+
+	if options.MinScore == 0 {
+		options.MinScore = 0.6
+	}
+
+	compact := strings.ReplaceAll(normalized, " ", "")
+
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	var results []SearchResult
+
+	for _, entry := range i.entries {
+		if options.Year != 0 && entry.movie.Year != options.Year {
+			continue
+		}
+
+		var best SearchResult
+
+		for _, title := range entry.titles {
+			score := scoreTitle(normalized, compact, title) * title.weight
+
+			if score > best.Score {
+				best = SearchResult{Movie: entry.movie, Score: score, MatchedTitle: title.original}
+			}
+		}
+
+		if best.Score >= options.MinScore {
+			results = append(results, best)
+		}
+	}
+
+	sort.SliceStable(results, func(a, b int) bool {
+		if results[a].Score != results[b].Score {
+			return results[a].Score > results[b].Score
+		}
+
+		return results[a].Movie.SortTitle < results[b].Movie.SortTitle
+	})
+
+	if options.Limit > 0 && len(results) > options.Limit {
+		results = results[:options.Limit]
+	}
+
+	return results, nil
+}
+
+// scoreTitle how well a normalized query matches an indexed title, from 0 to 1
+func scoreTitle(query, compact string, title indexTitle) float64 {
+	switch {
+	case query == title.normalized:
+		return 1
+	case compact == title.compact:
+		return 0.95
+	case strings.HasPrefix(title.normalized, query+" "):
+		// "the matrix" for "the matrix reloaded"
+		return 0.8 + 0.1*float64(len(query))/float64(len(title.normalized))
+	}
+
+	// best of comparing word by word and comparing the whole title
+	whole := similarity(compact, title.compact)
+	words := wordSimilarity(strings.Fields(query), strings.Fields(title.normalized))
+
+	if words > whole {
+		return 0.85 * words
+	}
+
+	return 0.85 * whole
+}
+
+// wordSimilarity averages how well each query word matches its closest title
+// word, lowered by the title words nothing matched
+func wordSimilarity(query, title []string) float64 {
+	if len(query) == 0 || len(title) == 0 {
+		return 0
+	}
+
+	var total float64
+	used := make(map[int]bool, len(title))
+
+	for _, word := range query {
+		best, bestIndex := 0.0, -1
+
+		for i, candidate := range title {
+			if used[i] {
+				continue
+			}
+
+			score := similarity(word, candidate)
+
+			// partially typed words, e.g. "matr"
+			if len(word) >= 3 && strings.HasPrefix(candidate, word) && score < 0.9 {
+				score = 0.9
+			}
+
+			if score > best {
+				best, bestIndex = score, i
+			}
+		}
+
+		if bestIndex != -1 {
+			used[bestIndex] = true
+		}
+
+		total += best
+	}
+
+	average := total / float64(len(query))
+	unmatched := len(title) - len(used)
+
+	return average * (1 - 0.1*float64(unmatched)/float64(len(title)))
+}
+
+// similarity 1 minus the edit distance relative to the longer string
+func similarity(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+
+	longest := len(ra)
+
+	if len(rb) > longest {
+		longest = len(rb)
+	}
+
+	if longest == 0 {
+		return 1
+	}
+
+	return 1 - float64(levenshtein(ra, rb))/float64(longest)
+}
+
+func levenshtein(a, b []rune) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+
+		previous, current = current, previous
+	}
+
+	return previous[len(b)]
+}
+
+// normalizeTitle lowercases, removes accents and replaces punctuation with
+// spaces so "Amélie: Le Fabuleux" becomes "amelie le fabuleux"
+func normalizeTitle(title string) string {
+	var b strings.Builder
+
+	space := false
+
+	for _, r := range strings.ToLower(title) {
+		if folded, ok := accentFolds[r]; ok {
+			b.WriteString(folded)
+			space = false
+			continue
+		}
+
+		switch {
+		case r == '\'' || r == '’':
+			// "don't" matches "dont"
+			continue
+		case r == '&':
+			if b.Len() > 0 && !space {
+				b.WriteByte(' ')
+			}
+
+			b.WriteString("and ")
+			space = true
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(r)
+			space = false
+		case unicode.Is(unicode.Mn, r):
+			// combining accents of decomposed text
+			continue
+		default:
+			if b.Len() > 0 && !space {
+				b.WriteByte(' ')
+				space = true
+			}
+		}
+	}
+
+	return strings.TrimSpace(b.String())
+}
+
+// accentFolds maps accented latin letters to their plain spelling
+var accentFolds = func() map[rune]string {
+	groups := map[string]string{
+		"a":  "àáâãäåāăą",
+		"c":  "çćĉċč",
+		"d":  "ďđð",
+		"e":  "èéêëēĕėęě",
+		"g":  "ĝğġģ",
+		"h":  "ĥħ",
+		"i":  "ìíîïĩīĭįı",
+		"j":  "ĵ",
+		"k":  "ķ",
+		"l":  "ĺļľŀł",
+		"n":  "ñńņňŉ",
+		"o":  "òóôõöøōŏő",
+		"r":  "ŕŗř",
+		"s":  "śŝşšș",
+		"t":  "ţťŧț",
+		"u":  "ùúûüũūŭůűų",
+		"w":  "ŵ",
+		"y":  "ýÿŷ",
+		"z":  "źżž",
+		"ae": "æ",
+		"oe": "œ",
+		"ss": "ß",
+		"th": "þ",
+	}
+
+	folds := map[rune]string{}
+
+	for plain, accented := range groups {
+		for _, r := range accented {
+			folds[r] = plain
+		}
+	}
+
+	return folds
+}()
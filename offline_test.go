@@ -0,0 +1,86 @@
+package radarr
+
+import (
+	"testing"
+)
+
+func TestNormalizeTitle(t *testing.T) {
+	tests := map[string]string{
+		"Amélie":                         "amelie",
+		"Léon: The Professional":         "leon the professional",
+		"Crouching Tiger, Hidden Dragon": "crouching tiger hidden dragon",
+		"Fast & Furious":                 "fast and furious",
+		"Don't Look Up":                  "dont look up",
+		"Æon Flux":                       "aeon flux",
+		"  Spaces -- everywhere  ":       "spaces everywhere",
+	}
+
+	for title, expected := range tests {
+		if got := normalizeTitle(title); got != expected {
+			t.Errorf("normalizeTitle(%q) = %q, expected %q", title, got, expected)
+		}
+	}
+}
+
+func TestMovieIndexSearch(t *testing.T) {
+	index := NewMovieIndex([]Movie{
+		{ID: 1, Title: "The Matrix", SortTitle: "matrix", CleanTitle: "thematrix", Year: 1999},
+		{ID: 2, Title: "The Matrix Reloaded", SortTitle: "matrix reloaded", CleanTitle: "thematrixreloaded", Year: 2003},
+		{ID: 3, Title: "Amélie", SortTitle: "amelie", CleanTitle: "amelie", Year: 2001, AlternativeTitles: []AlternativeTitle{
+			{Title: "Le Fabuleux Destin d'Amélie Poulain", Language: "french"},
+		}},
+		{ID: 4, Title: "Heat", SortTitle: "heat", CleanTitle: "heat", Year: 1995},
+	})
+
+	tests := []struct {
+		query   string
+		options OfflineSearchOptions
+		ids     []int
+	}{
+		{"the matrix", OfflineSearchOptions{}, []int{1, 2}},
+		{"matrix", OfflineSearchOptions{Limit: 1}, []int{1}},
+		{"The Matrix", OfflineSearchOptions{Year: 2003}, []int{2}},
+		{"teh matrix", OfflineSearchOptions{Limit: 1}, []int{1}},
+		{"amelie", OfflineSearchOptions{}, []int{3}},
+		{"fabuleux destin", OfflineSearchOptions{}, []int{3}},
+		{"AMÉLIE", OfflineSearchOptions{Year: 1999}, nil},
+		{"heat", OfflineSearchOptions{}, []int{4}},
+		{"jaws", OfflineSearchOptions{}, nil},
+	}
+
+	for _, test := range tests {
+		results, err := index.Search(test.query, test.options)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var ids []int
+
+		for _, result := range results {
+			ids = append(ids, result.Movie.ID)
+		}
+
+		if len(ids) != len(test.ids) {
+			t.Errorf("search %q %+v: expected %v, got %v", test.query, test.options, test.ids, results)
+			continue
+		}
+
+		for i := range ids {
+			if ids[i] != test.ids[i] {
+				t.Errorf("search %q %+v: expected %v, got %v", test.query, test.options, test.ids, ids)
+				break
+			}
+		}
+	}
+
+	results, _ := index.Search("the matrix", OfflineSearchOptions{})
+
+	if results[0].Score != 1 {
+		t.Errorf("expected an exact match to score 1, got %v", results[0].Score)
+	}
+
+	if _, err := index.Search(" ?! ", OfflineSearchOptions{}); err == nil {
+		t.Error("expected an empty query to return an error")
+	}
+}
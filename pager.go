@@ -0,0 +1,175 @@
+package radarr
+
+import "iter"
+
+// Page a single page of a paged endpoint
+type Page[T any] struct {
+	Page         int
+	PageSize     int
+	TotalRecords int
+	Records      []T
+}
+
+// PageFunc fetches a page by its number, starting at 1
+type PageFunc[T any] func(page int) (Page[T], error)
+
+// Pager walks every page of a paged endpoint lazily. While a page is being
+// consumed the next one is already fetched in the background
+type Pager[T any] struct {
+	fetch PageFunc[T]
+}
+
+// NewPager creates a pager that gets its pages from fetch
+func NewPager[T any](fetch PageFunc[T]) Pager[T] {
+	return Pager[T]{fetch: fetch}
+}
+
+// Pages yields every page in order until the last one. Paging stops at the
+// first error, which is yielded with an empty page
+func (p Pager[T]) Pages() iter.Seq2[Page[T], error] {
+	return func(yield func(Page[T], error) bool) {
+		type result struct {
+			page Page[T]
+			err  error
+		}
+
+		// buffered so a prefetch that is never read doesn't leak its goroutine
+		prefetch := func(number int) <-chan result {
+			ch := make(chan result, 1)
+
+			go func() {
+				page, err := p.fetch(number)
+				ch <- result{page, err}
+			}()
+
+			return ch
+		}
+
+		next := prefetch(1)
+
+		for number := 1; ; number++ {
+			r := <-next
+
+			if r.err != nil {
+				yield(Page[T]{}, r.err)
+				return
+			}
+
+			if len(r.page.Records) == 0 {
+				return
+			}
+
+			pageSize := r.page.PageSize
+
+			if pageSize == 0 {
+				pageSize = len(r.page.Records)
+			}
+
+			last := number*pageSize >= r.page.TotalRecords
+
+			if !last {
+				next = prefetch(number + 1)
+			}
+
+			if !yield(r.page, nil) || last {
+				return
+			}
+		}
+	}
+}
+
+// All yields every record of every page in order. Paging stops at the first
+// error, which is yielded with a zero record
+func (p Pager[T]) All() iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for page, err := range p.Pages() {
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			for _, record := range page.Records {
+				if !yield(record, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// AllMovies walks the library page by page. options.Page is ignored and a
+// PageSize that isn't positive pages by 100
+func (c Client) AllMovies(options GetMovieOptions) iter.Seq2[Movie, error] {
+	if options.PageSize < 1 {
+		options.PageSize = 100
+	}
+
+	return NewPager(func(page int) (Page[Movie], error) {
+		// copied per fetch since the next page is fetched in the background,
+		// possibly while an earlier iteration is still fetching
+		options := options
+		options.Page = page
+
+		library, err := c.GetLibrary(options)
+
+		return Page[Movie]{library.Page, library.PageSize, library.TotalRecords, library.Records}, err
+	}).All()
+}
+
+// AllWantedMissing walks every page of GetWantedMissing. options.Page is ignored
+func (c Client) AllWantedMissing(options WantedOptions) iter.Seq2[Movie, error] {
+	return c.allWanted(c.GetWantedMissing, options)
+}
+
+// AllWantedCutoff walks every page of GetWantedCutoff. options.Page is ignored
+func (c Client) AllWantedCutoff(options WantedOptions) iter.Seq2[Movie, error] {
+	return c.allWanted(c.GetWantedCutoff, options)
+}
+
+func (c Client) allWanted(get func(WantedOptions) (Wanted, error), options WantedOptions) iter.Seq2[Movie, error] {
+	return NewPager(func(page int) (Page[Movie], error) {
+		options := options
+		options.Page = page
+
+		wanted, err := get(options)
+
+		return Page[Movie]{wanted.Page, wanted.PageSize, wanted.TotalRecords, wanted.Records}, err
+	}).All()
+}
+
+// AllHistory walks every page of GetHistory. options.Page is ignored
+func (c Client) AllHistory(options HistoryOptions) iter.Seq2[HistoryRecord, error] {
+	return NewPager(func(page int) (Page[HistoryRecord], error) {
+		options := options
+		options.Page = page
+
+		history, err := c.GetHistory(options)
+
+		return Page[HistoryRecord]{history.Page, history.PageSize, history.TotalRecords, history.Records}, err
+	}).All()
+}
+
+// AllBlocklist walks every page of GetBlocklist. options.Page is ignored
+func (c Client) AllBlocklist(options BlocklistOptions) iter.Seq2[BlocklistItem, error] {
+	return NewPager(func(page int) (Page[BlocklistItem], error) {
+		options := options
+		options.Page = page
+
+		blocklist, err := c.GetBlocklist(options)
+
+		return Page[BlocklistItem]{blocklist.Page, blocklist.PageSize, blocklist.TotalRecords, blocklist.Records}, err
+	}).All()
+}
+
+// AllLogs walks every page of GetLogs. options.Page is ignored
+func (c Client) AllLogs(options LogOptions) iter.Seq2[LogRecord, error] {
+	return NewPager(func(page int) (Page[LogRecord], error) {
+		options := options
+		options.Page = page
+
+		logs, err := c.GetLogs(options)
+
+		return Page[LogRecord]{logs.Page, logs.PageSize, logs.TotalRecords, logs.Records}, err
+	}).All()
+}
@@ -0,0 +1,155 @@
+package radarr
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func TestPager(t *testing.T) {
+	var mu sync.Mutex
+	var fetched []int
+
+	pager := NewPager(func(page int) (Page[int], error) {
+		mu.Lock()
+		fetched = append(fetched, page)
+		mu.Unlock()
+
+		if page > 3 {
+			return Page[int]{}, errors.New("fetched past the last page")
+		}
+
+		records := []int{page*10 + 1, page*10 + 2}
+
+		if page == 3 {
+			records = records[:1]
+		}
+
+		return Page[int]{Page: page, PageSize: 2, TotalRecords: 5, Records: records}, nil
+	})
+
+	var all []int
+
+	for record, err := range pager.All() {
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		all = append(all, record)
+	}
+
+	if fmt.Sprint(all) != "[11 12 21 22 31]" {
+		t.Errorf("unexpected records: %v", all)
+	}
+
+	if fmt.Sprint(fetched) != "[1 2 3]" {
+		t.Errorf("expected each page to be fetched once in order, got %v", fetched)
+	}
+
+	// stopping early only prefetches the page after the one being consumed
+	fetched = nil
+
+	for record := range pager.All() {
+		if record == 12 {
+			break
+		}
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if len(fetched) > 2 {
+		t.Errorf("expected at most 2 pages to be fetched when stopping early, got %v", fetched)
+	}
+}
+
+func TestAllMovies(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+
+		if r.URL.Query().Get("pageSize") != "100" {
+			t.Errorf("expected the default page size of 100, got %s", r.URL.RawQuery)
+		}
+
+		if page == 2 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		fmt.Fprintf(w, `{"page":%d,"pageSize":100,"totalRecords":150,"records":[{"id":%d}]}`, page, page)
+	}))
+	defer server.Close()
+
+	client, err := New(server.URL, "abc123")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ids []int
+	var pageErr error
+
+	for movie, err := range client.AllMovies(GetMovieOptions{}) {
+		if err != nil {
+			pageErr = err
+			break
+		}
+
+		ids = append(ids, movie.ID)
+	}
+
+	if fmt.Sprint(ids) != "[1]" {
+		t.Errorf("unexpected movies: %v", ids)
+	}
+
+	if pageErr == nil {
+		t.Error("expected a failing page to stop with an error")
+	}
+}
+
+// iterating the same sequence again after stopping early must not share the
+// options with the prefetch that is still running, run with -race
+func TestAllHistoryReiterate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+
+		fmt.Fprintf(w, `{"page":%d,"pageSize":1,"totalRecords":3,"records":[{"id":%d}]}`, page, page)
+	}))
+	defer server.Close()
+
+	client, err := New(server.URL, "abc123")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	history := client.AllHistory(HistoryOptions{PageSize: 1})
+
+	for i := 0; i < 5; i++ {
+		for _, err := range history {
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			break
+		}
+	}
+
+	var ids []int
+
+	for record, err := range history {
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ids = append(ids, record.ID)
+	}
+
+	if fmt.Sprint(ids) != "[1 2 3]" {
+		t.Errorf("unexpected records: %v", ids)
+	}
+}
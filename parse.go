@@ -0,0 +1,71 @@
+package radarr
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+)
+
+// ParsedMovieInfo how radarr interprets a release title
+type ParsedMovieInfo struct {
+	MovieTitle string `json:"movieTitle"`
+	Year       int    `json:"year"`
+	Quality    struct {
+		Quality struct {
+			ID         int    `json:"id"`
+			Name       string `json:"name"`
+			Source     string `json:"source"`
+			Resolution int    `json:"resolution"`
+		} `json:"quality"`
+		Revision struct {
+			Version  int  `json:"version"`
+			Real     int  `json:"real"`
+			IsRepack bool `json:"isRepack"`
+		} `json:"revision"`
+	} `json:"quality"`
+	Languages    []string `json:"languages"`
+	Edition      string   `json:"edition"`
+	ReleaseGroup string   `json:"releaseGroup"`
+	ReleaseHash  string   `json:"releaseHash"`
+	ImdbID       string   `json:"imdbId"`
+}
+
+// ParseResult the parsed release title and the library movie it matched, if any
+type ParseResult struct {
+	Title           string          `json:"title"`
+	ParsedMovieInfo ParsedMovieInfo `json:"parsedMovieInfo"`
+	Movie           *Movie          `json:"movie"`
+}
+
+// Parse asks radarr how it would interpret a release title. See the release
+// package to parse titles without a round trip to the server
+func (c Client) Parse(title string) (ParseResult, error) {
+	const endpoint = "/api/parse"
+
+	var result ParseResult
+
+	if title == "" {
+		return result, errors.New("title is required")
+	}
+
+	params := url.Values{}
+
+	params.Set("title", title)
+
+	resp, err := c.get(endpoint, params)
+
+	if err != nil {
+		return result, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return result, errors.New(resp.Status)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&result)
+
+	return result, err
+}
@@ -0,0 +1,56 @@
+package radarr
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+// QueueItem a release radarr has sent to a download client
+type QueueItem struct {
+	ID      int   `json:"id"`
+	Movie   Movie `json:"movie"`
+	Quality struct {
+		Quality struct {
+			ID   int    `json:"id"`
+			Name string `json:"name"`
+		} `json:"quality"`
+	} `json:"quality"`
+	Size                    float64 `json:"size"`
+	Sizeleft                float64 `json:"sizeleft"`
+	Title                   string  `json:"title"`
+	Timeleft                string  `json:"timeleft"`
+	EstimatedCompletionTime string  `json:"estimatedCompletionTime"`
+	Status                  string  `json:"status"`
+	TrackedDownloadStatus   string  `json:"trackedDownloadStatus"`
+	StatusMessages          []struct {
+		Title    string   `json:"title"`
+		Messages []string `json:"messages"`
+	} `json:"statusMessages"`
+	DownloadID string `json:"downloadId"`
+	Protocol   string `json:"protocol"`
+	// OutputPath where the download client is saving the release
+	OutputPath string `json:"outputPath"`
+}
+
+// GetQueue returns the releases currently being downloaded
+func (c Client) GetQueue() ([]QueueItem, error) {
+	const endpoint = "/api/queue"
+	var queue []QueueItem
+
+	resp, err := c.get(endpoint, nil)
+
+	if err != nil {
+		return queue, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return queue, errors.New(resp.Status)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&queue)
+
+	return queue, err
+}
@@ -0,0 +1,307 @@
+// Package release parses scene and p2p release names offline, e.g.
+// "The.Matrix.1999.2160p.UHD.BluRay.x265.HDR-GROUP", without asking radarr
+package release
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Source where a release was ripped from
+type Source string
+
+const (
+	// SourceUnknown no source was found in the name
+	SourceUnknown Source = ""
+	// SourceCam recorded in a theater
+	SourceCam Source = "CAM"
+	// SourceTelesync recorded in a theater with a direct audio feed
+	SourceTelesync Source = "TELESYNC"
+	// SourceTelecine copied from film reel
+	SourceTelecine Source = "TELECINE"
+	// SourceScreener advance copy sent to reviewers
+	SourceScreener Source = "SCREENER"
+	// SourceDVD ripped from a dvd
+	SourceDVD Source = "DVD"
+	// SourceHDTV captured from a broadcast
+	SourceHDTV Source = "HDTV"
+	// SourceWEBRip captured from a streaming service
+	SourceWEBRip Source = "WEBRip"
+	// SourceWEBDL downloaded untouched from a streaming service
+	SourceWEBDL Source = "WEBDL"
+	// SourceBluRay ripped from a blu-ray
+	SourceBluRay Source = "BluRay"
+)
+
+// HDR formats
+const (
+	HDR         = "HDR"
+	HDR10       = "HDR10"
+	HDR10Plus   = "HDR10+"
+	DolbyVision = "DV"
+	HLG         = "HLG"
+)
+
+// Info what could be parsed from a release name. Fields are left empty
+// when they aren't present in the name
+type Info struct {
+	Title string
+	Year  int
+	// Resolution e.g. 2160p, 1080p, 720p
+	Resolution string
+	Source     Source
+	// Remux an untouched copy of the disc
+	Remux bool
+	// Codec e.g. x265, x264, AV1
+	Codec string
+	// HDR formats found in the name, e.g. HDR10, DV
+	HDR []string
+	// Edition e.g. Director's Cut, Extended, IMAX
+	Edition string
+	Group   string
+	Proper  bool
+	Repack  bool
+}
+
+type pattern struct {
+	value string
+	re    *regexp.Regexp
+}
+
+func patterns(pairs ...string) []pattern {
+	var list []pattern
+
+	for i := 0; i+1 < len(pairs); i += 2 {
+		list = append(list, pattern{pairs[i], regexp.MustCompile(`(?i)(?:^| )(?:` + pairs[i+1] + `)(?: |$)`)})
+	}
+
+	return list
+}
+
+// editionPairs edition names and the expression that finds them
+var editionPairs = []string{
+	"Director's Cut", `directors?'?s? ?cut|dc`,
+	"Extended", `extended(?: cut| edition)?`,
+	"Unrated", `unrated`,
+	"Uncut", `uncut`,
+	"Theatrical", `theatrical(?: cut| edition)?`,
+	"Final Cut", `final cut`,
+	"Ultimate", `ultimate(?: cut| edition)?`,
+	"Special Edition", `special edition`,
+	"Collector's Edition", `collectors?'?s? edition`,
+	"Anniversary Edition", `\d+(?:th|st|nd|rd)? anniversary(?: edition)?|anniversary edition`,
+	"Criterion", `criterion(?: collection)?`,
+	"Remastered", `remastered|4k remaster(?:ed)?`,
+	"IMAX", `imax`,
+	"Open Matte", `open matte`,
+}
+
+// order matters -- the first match wins
+var (
+	resolutions = patterns(
+		"2160p", `2160p|4k|uhd`,
+		"1080p", `1080[pi]`,
+		"720p", `720p`,
+		"576p", `576p`,
+		"480p", `480p|640x480|848x480`,
+	)
+
+	sources = patterns(
+		string(SourceCam), `cam|camrip|hdcam|cam-rip`,
+		string(SourceTelesync), `ts|telesync|hdts|pdvd|hd-ts`,
+		string(SourceTelecine), `tc|telecine|hdtc|hd-tc`,
+		string(SourceScreener), `scr|screener|dvdscr|dvdscreener|bdscr`,
+		string(SourceBluRay), `blu-?ray|bdrip|brrip|bd-?rip|bd25|bd50|bd|uhd ?bluray|remux`,
+		string(SourceWEBRip), `web-?rip|webrip`,
+		string(SourceWEBDL), `web-?dl|webdl|web|amzn|nf|dsnp|atvp|hmax|hulu`,
+		string(SourceHDTV), `hdtv|pdtv|dsr|tvrip`,
+		string(SourceDVD), `dvd|dvdrip|dvd-?r|dvd5|dvd9|ntsc|pal`,
+	)
+
+	codecs = patterns(
+		"x265", `x265|h ?265|hevc`,
+		"x264", `x264|h ?264|avc`,
+		"AV1", `av1`,
+		"VC-1", `vc-?1`,
+		"MPEG-2", `mpeg-?2`,
+		"XviD", `xvid|divx`,
+	)
+
+	hdrFormats = patterns(
+		DolbyVision, `dv|dovi|dolby ?vision`,
+		HDR10Plus, `hdr10(?:\+|plus)`,
+		HDR10, `hdr10`,
+		HLG, `hlg`,
+		HDR, `hdr`,
+	)
+
+	editions = patterns(editionPairs...)
+
+	proper = patterns("PROPER", `proper`)
+	repack = patterns("REPACK", `repack|rerip`)
+)
+
+var (
+	extensionRe = regexp.MustCompile(`(?i)\.(?:mkv|mp4|avi|m4v|wmv|mov|m2ts|iso|nzb|torrent)$`)
+	// trailing site tag e.g. [rarbg] or [YTS.MX]
+	trailingTagRe = regexp.MustCompile(`\s*\[[^\]]*\]$`)
+	// leading group tags e.g. [Group] The Matrix
+	leadingTagRe = regexp.MustCompile(`^\[([^\]]+)\]\s*`)
+	groupRe      = regexp.MustCompile(`-([^\s.\-\[\]()]+)$`)
+	tokenRe      = regexp.MustCompile(`[^\s._()\[\]]+`)
+	yearRe       = regexp.MustCompile(`^(?:19|20)\d{2}$`)
+	remuxRe      = regexp.MustCompile(`(?i)(?:^| )remux(?: |$)`)
+	// an edition at the very end of a title
+	editionSuffixRe = regexp.MustCompile(`(?i) (?:` + editionExpressions() + `)$`)
+	// tokens that mark the end of a title when a name has no year
+	markerRe = regexp.MustCompile(`(?i)^(?:2160p|1080[pi]|720p|576p|480p|4k|uhd|blu-?ray|bdrip|brrip|remux|web|web-?dl|webrip|web-?rip|hdtv|dvdrip|dvd|x264|x265|h264|h265|hevc|xvid|hdrip|cam|hdcam|telesync|hdts|proper|repack)$`)
+)
+
+// groups that are really the end of a source tag, e.g. WEB-DL
+var notGroups = map[string]bool{
+	"dl":  true,
+	"rip": true,
+	"ray": true,
+	"hd":  true,
+	"ts":  true,
+	"tc":  true,
+	"1":   true,
+	"2":   true,
+	"r":   true,
+}
+
+// Parse extracts whatever it can find from a release name
+func Parse(name string) Info {
+	var info Info
+
+	name = strings.TrimSpace(name)
+	name = extensionRe.ReplaceAllString(name, "")
+	name = trailingTagRe.ReplaceAllString(name, "")
+
+	if match := leadingTagRe.FindStringSubmatch(name); match != nil {
+		info.Group = match[1]
+		name = name[len(match[0]):]
+	}
+
+	// e.g. Heat-1995-1080p-BluRay-x264-GRP, where the hyphens separate every token
+	hyphenated := !strings.ContainsAny(name, " ._") && strings.Count(name, "-") > 1
+
+	if match := groupRe.FindStringSubmatchIndex(name); match != nil {
+		group := name[match[2]:match[3]]
+
+		if !notGroups[strings.ToLower(group)] && !markerRe.MatchString(group) {
+			info.Group = group
+			name = name[:match[0]]
+		}
+	}
+
+	if hyphenated {
+		name = strings.ReplaceAll(name, "-", " ")
+	}
+
+	tokens := tokenRe.FindAllString(name, -1)
+
+	// the title ends at the first quality marker or at the last year before it
+	titleEnd := len(tokens)
+
+	for i := 1; i < len(tokens); i++ {
+		if markerRe.MatchString(tokens[i]) {
+			titleEnd = i
+			break
+		}
+	}
+
+	yearIndex := -1
+
+	for i := titleEnd - 1; i > 0; i-- {
+		if yearRe.MatchString(tokens[i]) {
+			yearIndex = i
+			break
+		}
+	}
+
+	rest := tokens[titleEnd:]
+
+	if yearIndex > 0 {
+		info.Year, _ = strconv.Atoi(tokens[yearIndex])
+		rest = tokens[yearIndex+1:]
+		titleEnd = yearIndex
+	}
+
+	titleTokens := tokens[:titleEnd]
+	tail := strings.Join(rest, " ")
+
+	// editions sometimes sit between the title and the year
+	for {
+		joined := strings.Join(titleTokens, " ")
+		loc := editionSuffixRe.FindStringIndex(joined)
+
+		if loc == nil || loc[0] == 0 {
+			break
+		}
+
+		titleTokens = strings.Fields(joined[:loc[0]])
+		tail = strings.TrimSpace(joined[loc[0]:] + " " + tail)
+	}
+
+	info.Title = strings.TrimSpace(strings.Trim(strings.Join(titleTokens, " "), "-"))
+	info.Resolution = match(resolutions, tail)
+	info.Source = Source(match(sources, tail))
+	info.Remux = remuxRe.MatchString(tail)
+	info.Codec = match(codecs, tail)
+	info.HDR = matchAll(hdrFormats, tail)
+	info.Edition = strings.Join(matchAll(editions, tail), " ")
+	info.Proper = match(proper, tail) != ""
+	info.Repack = match(repack, tail) != ""
+
+	// HDR is implied by the more specific formats
+	if len(info.HDR) > 1 {
+		var formats []string
+
+		for _, format := range info.HDR {
+			if format != HDR {
+				formats = append(formats, format)
+			}
+		}
+
+		info.HDR = formats
+	}
+
+	return info
+}
+
+// match returns the value of the first pattern found in s
+func match(list []pattern, s string) string {
+	for _, p := range list {
+		if p.re.MatchString(s) {
+			return p.value
+		}
+	}
+
+	return ""
+}
+
+// matchAll returns the value of every pattern found in s
+func matchAll(list []pattern, s string) []string {
+	var values []string
+
+	for _, p := range list {
+		if p.re.MatchString(s) {
+			values = append(values, p.value)
+		}
+	}
+
+	return values
+}
+
+// editionExpressions joins every edition expression into one alternation
+func editionExpressions() string {
+	var expressions []string
+
+	for i := 1; i < len(editionPairs); i += 2 {
+		expressions = append(expressions, editionPairs[i])
+	}
+
+	return strings.Join(expressions, "|")
+}
@@ -0,0 +1,216 @@
+package release
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected Info
+	}{
+		{
+			"The.Matrix.1999.1080p.BluRay.x264-SPARKS",
+			Info{Title: "The Matrix", Year: 1999, Resolution: "1080p", Source: SourceBluRay, Codec: "x264", Group: "SPARKS"},
+		},
+		{
+			"The.Matrix.1999.2160p.UHD.BluRay.x265.10bit.HDR.DTS-HD.MA.5.1-SWTYBLZ",
+			Info{Title: "The Matrix", Year: 1999, Resolution: "2160p", Source: SourceBluRay, Codec: "x265", HDR: []string{HDR}, Group: "SWTYBLZ"},
+		},
+		{
+			"The Matrix (1999) 720p BRRip x264-YIFY",
+			Info{Title: "The Matrix", Year: 1999, Resolution: "720p", Source: SourceBluRay, Codec: "x264", Group: "YIFY"},
+		},
+		{
+			"Blade.Runner.2049.2017.2160p.UHD.BluRay.REMUX.HDR.HEVC.Atmos-EPSiLON",
+			Info{Title: "Blade Runner 2049", Year: 2017, Resolution: "2160p", Source: SourceBluRay, Remux: true, Codec: "x265", HDR: []string{HDR}, Group: "EPSiLON"},
+		},
+		{
+			"2001.A.Space.Odyssey.1968.720p.BluRay.DD5.1.x264-CtrlHD",
+			Info{Title: "2001 A Space Odyssey", Year: 1968, Resolution: "720p", Source: SourceBluRay, Codec: "x264", Group: "CtrlHD"},
+		},
+		{
+			"Heat-1995-1080p-BluRay-x264-GRP",
+			Info{Title: "Heat", Year: 1995, Resolution: "1080p", Source: SourceBluRay, Codec: "x264", Group: "GRP"},
+		},
+		{
+			"The-Dark-Knight-2008-720p-WEB-DL-H264-NTb",
+			Info{Title: "The Dark Knight", Year: 2008, Resolution: "720p", Source: SourceWEBDL, Codec: "x264", Group: "NTb"},
+		},
+		{
+			"Heat-1995-2160p-UHD-BluRay-x265",
+			Info{Title: "Heat", Year: 1995, Resolution: "2160p", Source: SourceBluRay, Codec: "x265"},
+		},
+		{
+			"Spider-Man.2002.1080p.BluRay.x264-GRP",
+			Info{Title: "Spider-Man", Year: 2002, Resolution: "1080p", Source: SourceBluRay, Codec: "x264", Group: "GRP"},
+		},
+		{
+			"1917.2019.1080p.WEB-DL.H264.AC3-EVO",
+			Info{Title: "1917", Year: 2019, Resolution: "1080p", Source: SourceWEBDL, Codec: "x264", Group: "EVO"},
+		},
+		{
+			"Dune.Part.Two.2024.2160p.AMZN.WEB-DL.DDP5.1.Atmos.DV.HDR10.H.265-FLUX",
+			Info{Title: "Dune Part Two", Year: 2024, Resolution: "2160p", Source: SourceWEBDL, Codec: "x265", HDR: []string{DolbyVision, HDR10}, Group: "FLUX"},
+		},
+		{
+			"Oppenheimer.2023.2160p.WEBRip.x265.10bit.HDR10Plus-RARBG",
+			Info{Title: "Oppenheimer", Year: 2023, Resolution: "2160p", Source: SourceWEBRip, Codec: "x265", HDR: []string{HDR10Plus}, Group: "RARBG"},
+		},
+		{
+			"Top.Gun.Maverick.2022.2160p.WEB-DL.DDP5.1.Atmos.HDR10+.HEVC-TEPES",
+			Info{Title: "Top Gun Maverick", Year: 2022, Resolution: "2160p", Source: SourceWEBDL, Codec: "x265", HDR: []string{HDR10Plus}, Group: "TEPES"},
+		},
+		{
+			"Avatar.The.Way.of.Water.2022.HDCAM.x264-NOGRP",
+			Info{Title: "Avatar The Way of Water", Year: 2022, Source: SourceCam, Codec: "x264", Group: "NOGRP"},
+		},
+		{
+			"Barbie.2023.TS.XviD-ETRG",
+			Info{Title: "Barbie", Year: 2023, Source: SourceTelesync, Codec: "XviD", Group: "ETRG"},
+		},
+		{
+			"Barbie.2023.HDTS.1080p.x264",
+			Info{Title: "Barbie", Year: 2023, Resolution: "1080p", Source: SourceTelesync, Codec: "x264"},
+		},
+		{
+			"The.Irishman.2019.DVDScr.XviD-EVO",
+			Info{Title: "The Irishman", Year: 2019, Source: SourceScreener, Codec: "XviD", Group: "EVO"},
+		},
+		{
+			"Casablanca.1942.DVDRip.XviD-FRAGMENT",
+			Info{Title: "Casablanca", Year: 1942, Source: SourceDVD, Codec: "XviD", Group: "FRAGMENT"},
+		},
+		{
+			"Some.Movie.2010.720p.HDTV.x264-DIMENSION",
+			Info{Title: "Some Movie", Year: 2010, Resolution: "720p", Source: SourceHDTV, Codec: "x264", Group: "DIMENSION"},
+		},
+		{
+			"Blade.Runner.1982.The.Final.Cut.1080p.BluRay.x264-SADPANDA",
+			Info{Title: "Blade Runner", Year: 1982, Resolution: "1080p", Source: SourceBluRay, Codec: "x264", Edition: "Final Cut", Group: "SADPANDA"},
+		},
+		{
+			"Kingdom.of.Heaven.2005.Directors.Cut.1080p.BluRay.x264-AMIABLE",
+			Info{Title: "Kingdom of Heaven", Year: 2005, Resolution: "1080p", Source: SourceBluRay, Codec: "x264", Edition: "Director's Cut", Group: "AMIABLE"},
+		},
+		{
+			"Kingdom.of.Heaven.Directors.Cut.2005.720p.BluRay.x264-CtrlHD",
+			Info{Title: "Kingdom of Heaven", Year: 2005, Resolution: "720p", Source: SourceBluRay, Codec: "x264", Edition: "Director's Cut", Group: "CtrlHD"},
+		},
+		{
+			"The.Lord.of.the.Rings.The.Fellowship.of.the.Ring.2001.EXTENDED.2160p.UHD.BluRay.x265-TERMiNAL",
+			Info{Title: "The Lord of the Rings The Fellowship of the Ring", Year: 2001, Resolution: "2160p", Source: SourceBluRay, Codec: "x265", Edition: "Extended", Group: "TERMiNAL"},
+		},
+		{
+			"Apocalypse.Now.1979.Final.Cut.REMASTERED.1080p.BluRay.x264-DEPTH",
+			Info{Title: "Apocalypse Now", Year: 1979, Resolution: "1080p", Source: SourceBluRay, Codec: "x264", Edition: "Final Cut Remastered", Group: "DEPTH"},
+		},
+		{
+			"Interstellar.2014.IMAX.2160p.WEB-DL.DDP5.1.HEVC-NOGRP",
+			Info{Title: "Interstellar", Year: 2014, Resolution: "2160p", Source: SourceWEBDL, Codec: "x265", Edition: "IMAX", Group: "NOGRP"},
+		},
+		{
+			"Alien.1979.Theatrical.Cut.1080p.BluRay.x264-HANDJOB",
+			Info{Title: "Alien", Year: 1979, Resolution: "1080p", Source: SourceBluRay, Codec: "x264", Edition: "Theatrical", Group: "HANDJOB"},
+		},
+		{
+			"Watchmen.2009.Ultimate.Cut.720p.BluRay.x264-HDEX",
+			Info{Title: "Watchmen", Year: 2009, Resolution: "720p", Source: SourceBluRay, Codec: "x264", Edition: "Ultimate", Group: "HDEX"},
+		},
+		{
+			"Jaws.1975.25th.Anniversary.Edition.1080p.BluRay.x264-GROUP",
+			Info{Title: "Jaws", Year: 1975, Resolution: "1080p", Source: SourceBluRay, Codec: "x264", Edition: "Anniversary Edition", Group: "GROUP"},
+		},
+		{
+			"Seven.Samurai.1954.Criterion.Collection.1080p.BluRay.x264-AMIABLE",
+			Info{Title: "Seven Samurai", Year: 1954, Resolution: "1080p", Source: SourceBluRay, Codec: "x264", Edition: "Criterion", Group: "AMIABLE"},
+		},
+		{
+			"Spider-Man.No.Way.Home.2021.1080p.WEBRip.x264-RARBG",
+			Info{Title: "Spider-Man No Way Home", Year: 2021, Resolution: "1080p", Source: SourceWEBRip, Codec: "x264", Group: "RARBG"},
+		},
+		{
+			"Spider-Man.Across.the.Spider-Verse.2023.1080p.NF.WEB-DL.DDP5.1.Atmos.H.264-FLUX.mkv",
+			Info{Title: "Spider-Man Across the Spider-Verse", Year: 2023, Resolution: "1080p", Source: SourceWEBDL, Codec: "x264", Group: "FLUX"},
+		},
+		{
+			"Everything Everywhere All at Once (2022) [2160p] [4K] [WEB] [5.1] [YTS.MX]",
+			Info{Title: "Everything Everywhere All at Once", Year: 2022, Resolution: "2160p", Source: SourceWEBDL},
+		},
+		{
+			"Parasite.2019.1080p.BluRay.x264.DTS-FGT [rarbg]",
+			Info{Title: "Parasite", Year: 2019, Resolution: "1080p", Source: SourceBluRay, Codec: "x264", Group: "FGT"},
+		},
+		{
+			"[TGx] The Batman 2022 1080p WEBRip x265",
+			Info{Title: "The Batman", Year: 2022, Resolution: "1080p", Source: SourceWEBRip, Codec: "x265", Group: "TGx"},
+		},
+		{
+			"The_Shawshank_Redemption_1994_720p_BluRay_x264",
+			Info{Title: "The Shawshank Redemption", Year: 1994, Resolution: "720p", Source: SourceBluRay, Codec: "x264"},
+		},
+		{
+			"Mad.Max.Fury.Road.2015.PROPER.1080p.BluRay.x264-GECKOS",
+			Info{Title: "Mad Max Fury Road", Year: 2015, Resolution: "1080p", Source: SourceBluRay, Codec: "x264", Group: "GECKOS", Proper: true},
+		},
+		{
+			"Mad.Max.Fury.Road.2015.REPACK.720p.WEB-DL.DD5.1.H264-FGT",
+			Info{Title: "Mad Max Fury Road", Year: 2015, Resolution: "720p", Source: SourceWEBDL, Codec: "x264", Group: "FGT", Repack: true},
+		},
+		{
+			"Arrival.2016.1080p.BluRay.AV1.Opus-NOGRP",
+			Info{Title: "Arrival", Year: 2016, Resolution: "1080p", Source: SourceBluRay, Codec: "AV1", Group: "NOGRP"},
+		},
+		{
+			"Heat.1995.Remastered.1080p.BluRay.VC-1.DTS-HD.MA.5.1-FGT",
+			Info{Title: "Heat", Year: 1995, Resolution: "1080p", Source: SourceBluRay, Codec: "VC-1", Edition: "Remastered", Group: "FGT"},
+		},
+		{
+			"The.Godfather.1972.1080p.BluRay.AVC.REMUX.TrueHD.5.1-FraMeSToR",
+			Info{Title: "The Godfather", Year: 1972, Resolution: "1080p", Source: SourceBluRay, Remux: true, Codec: "x264", Group: "FraMeSToR"},
+		},
+		{
+			"Nosferatu.1922.576p.DVD.MPEG-2-NOGRP",
+			Info{Title: "Nosferatu", Year: 1922, Resolution: "576p", Source: SourceDVD, Codec: "MPEG-2", Group: "NOGRP"},
+		},
+		{
+			"Planet.Earth.II.2016.2160p.UHD.BluRay.x265.HLG-NOGRP",
+			Info{Title: "Planet Earth II", Year: 2016, Resolution: "2160p", Source: SourceBluRay, Codec: "x265", HDR: []string{HLG}, Group: "NOGRP"},
+		},
+		{
+			"Tenet.2020.2160p.UHD.BluRay.x265.DoVi.HDR10-SWTYBLZ",
+			Info{Title: "Tenet", Year: 2020, Resolution: "2160p", Source: SourceBluRay, Codec: "x265", HDR: []string{DolbyVision, HDR10}, Group: "SWTYBLZ"},
+		},
+		{
+			"Zack.Snyders.Justice.League.2021.Open.Matte.1080p.WEB-DL-NOGRP",
+			Info{Title: "Zack Snyders Justice League", Year: 2021, Resolution: "1080p", Source: SourceWEBDL, Edition: "Open Matte", Group: "NOGRP"},
+		},
+		{
+			"Ex.Machina.2014.UNRATED.480p.DVDRip.XviD",
+			Info{Title: "Ex Machina", Year: 2014, Resolution: "480p", Source: SourceDVD, Codec: "XviD", Edition: "Unrated"},
+		},
+		{
+			"Amelie.2001.FRENCH.1080p.BluRay.x264-LOST",
+			Info{Title: "Amelie", Year: 2001, Resolution: "1080p", Source: SourceBluRay, Codec: "x264", Group: "LOST"},
+		},
+		{
+			"Movie.Without.A.Year.1080p.WEB-DL",
+			Info{Title: "Movie Without A Year", Resolution: "1080p", Source: SourceWEBDL},
+		},
+		{
+			"Just.A.Title",
+			Info{Title: "Just A Title"},
+		},
+		{
+			"",
+			Info{},
+		},
+	}
+
+	for _, test := range tests {
+		if parsed := Parse(test.name); !reflect.DeepEqual(parsed, test.expected) {
+			t.Errorf("%q\nexpected\n\t%+v\ngot\n\t%+v", test.name, test.expected, parsed)
+		}
+	}
+}
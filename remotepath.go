@@ -0,0 +1,280 @@
+package radarr
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// RemotePathMapping tells radarr where a download client's path lives on
+// radarr's side, e.g. when the download client runs in another container
+type RemotePathMapping struct {
+	ID int `json:"id,omitempty"`
+	// Host of the download client as entered in radarr
+	Host string `json:"host"`
+	// RemotePath the path as the download client reports it
+	RemotePath string `json:"remotePath"`
+	// LocalPath the same path as radarr sees it
+	LocalPath string `json:"localPath"`
+
+	Unknown
+}
+
+// UnmarshalJSON decodes the remote path mapping and keeps the fields it doesn't model in Extra
+func (m *RemotePathMapping) UnmarshalJSON(data []byte) error {
+	type plain RemotePathMapping
+
+	return unmarshalUnknown(data, (*plain)(m), &m.Unknown)
+}
+
+// MarshalJSON encodes the remote path mapping with Extra merged back in
+func (m RemotePathMapping) MarshalJSON() ([]byte, error) {
+	type plain RemotePathMapping
+
+	return marshalUnknown(plain(m), m.Unknown)
+}
+
+func (m RemotePathMapping) validate() error {
+	if m.Host == "" {
+		return errors.New("host is required")
+	}
+
+	if m.RemotePath == "" || m.LocalPath == "" {
+		return errors.New("both remote and local paths are required")
+	}
+
+	return nil
+}
+
+// GetRemotePathMappings returns every remote path mapping
+func (c Client) GetRemotePathMappings() ([]RemotePathMapping, error) {
+	const endpoint = "/api/remotepathmapping"
+	var mappings []RemotePathMapping
+
+	resp, err := c.get(endpoint, nil)
+
+	if err != nil {
+		return mappings, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return mappings, errors.New(resp.Status)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&mappings)
+
+	return mappings, err
+}
+
+// CreateRemotePathMapping adds a remote path mapping and returns it with its new id
+func (c Client) CreateRemotePathMapping(mapping RemotePathMapping) (RemotePathMapping, error) {
+	const endpoint = "/api/remotepathmapping"
+
+	if err := mapping.validate(); err != nil {
+		return mapping, err
+	}
+
+	mapping.ID = 0
+
+	requestPayload, err := json.Marshal(mapping)
+
+	if err != nil {
+		return mapping, err
+	}
+
+	resp, err := c.post(endpoint, requestPayload)
+
+	if err != nil {
+		return mapping, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return mapping, errors.New(resp.Status)
+	}
+
+	var created RemotePathMapping
+
+	err = json.NewDecoder(resp.Body).Decode(&created)
+
+	return created, err
+}
+
+// UpdateRemotePathMapping replaces the remote path mapping with the same id
+func (c Client) UpdateRemotePathMapping(mapping RemotePathMapping) (RemotePathMapping, error) {
+	const endpoint = "/api/remotepathmapping/%d"
+
+	if mapping.ID == 0 {
+		return mapping, errors.New("id is required")
+	}
+
+	if err := mapping.validate(); err != nil {
+		return mapping, err
+	}
+
+	requestPayload, err := json.Marshal(mapping)
+
+	if err != nil {
+		return mapping, err
+	}
+
+	resp, err := c.put(fmt.Sprintf(endpoint, mapping.ID), requestPayload)
+
+	if err != nil {
+		return mapping, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusAccepted && resp.StatusCode != http.StatusOK {
+		return mapping, errors.New(resp.Status)
+	}
+
+	var updated RemotePathMapping
+
+	err = json.NewDecoder(resp.Body).Decode(&updated)
+
+	return updated, err
+}
+
+// DeleteRemotePathMapping removes a remote path mapping
+func (c Client) DeleteRemotePathMapping(id int) error {
+	const endpoint = "/api/remotepathmapping/%d"
+
+	resp, err := c.delete(fmt.Sprintf(endpoint, id), nil)
+
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return errors.New(resp.Status)
+	}
+
+	return nil
+}
+
+// PathMapping a folder as radarr sees it and the same folder on our machine
+type PathMapping struct {
+	RadarrPath string
+	LocalPath  string
+}
+
+// PathMapper translates paths between radarr's view of the filesystem and
+// ours, e.g. /movies in radarr's container and /mnt/media/movies locally.
+// Paths that no mapping covers are returned unchanged
+type PathMapper struct {
+	mappings []PathMapping
+}
+
+// NewPathMapper creates a mapper -- when mappings overlap the longest one wins
+func NewPathMapper(mappings ...PathMapping) PathMapper {
+	sorted := make([]PathMapping, len(mappings))
+
+	copy(sorted, mappings)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return len(sorted[i].RadarrPath) > len(sorted[j].RadarrPath)
+	})
+
+	return PathMapper{mappings: sorted}
+}
+
+// ToLocal translates a path radarr reports to our view
+func (m PathMapper) ToLocal(path string) string {
+	for _, mapping := range m.mappings {
+		if translated, ok := translatePath(path, mapping.RadarrPath, mapping.LocalPath); ok {
+			return translated
+		}
+	}
+
+	return path
+}
+
+// ToRadarr translates one of our paths to radarr's view, e.g. before setting
+// Movie.Path for AddMovie
+func (m PathMapper) ToRadarr(path string) string {
+	mappings := make([]PathMapping, len(m.mappings))
+
+	copy(mappings, m.mappings)
+
+	sort.SliceStable(mappings, func(i, j int) bool {
+		return len(mappings[i].LocalPath) > len(mappings[j].LocalPath)
+	})
+
+	for _, mapping := range mappings {
+		if translated, ok := translatePath(path, mapping.LocalPath, mapping.RadarrPath); ok {
+			return translated
+		}
+	}
+
+	return path
+}
+
+// Movie returns a copy of movie with its paths translated to our view
+func (m PathMapper) Movie(movie Movie) Movie {
+	movie.Path = m.ToLocal(movie.Path)
+	movie.RootFolderPath = m.ToLocal(movie.RootFolderPath)
+
+	return movie
+}
+
+// RootFolder returns a copy of folder with its paths translated to our view
+func (m PathMapper) RootFolder(folder RootFolder) RootFolder {
+	folder.Path = m.ToLocal(folder.Path)
+
+	unmapped := folder.UnmappedFolders
+	folder.UnmappedFolders = append(unmapped[:0:0], unmapped...)
+
+	for i := range folder.UnmappedFolders {
+		folder.UnmappedFolders[i].Path = m.ToLocal(folder.UnmappedFolders[i].Path)
+	}
+
+	return folder
+}
+
+// QueueItem returns a copy of item with its paths translated to our view
+func (m PathMapper) QueueItem(item QueueItem) QueueItem {
+	item.OutputPath = m.ToLocal(item.OutputPath)
+	item.Movie = m.Movie(item.Movie)
+
+	return item
+}
+
+// translatePath swaps the from prefix of path for to. Prefixes only match
+// whole folders and the separators of the remainder follow the style of to,
+// so windows paths can be mapped to unix ones and back
+func translatePath(path, from, to string) (string, bool) {
+	if path == "" || from == "" {
+		return path, false
+	}
+
+	from = strings.TrimRight(from, `/\`)
+	to = strings.TrimRight(to, `/\`)
+
+	if !strings.HasPrefix(path, from) {
+		return path, false
+	}
+
+	rest := path[len(from):]
+
+	if rest != "" && rest[0] != '/' && rest[0] != '\\' {
+		return path, false
+	}
+
+	if strings.Contains(to, `\`) || (!strings.Contains(to, "/") && strings.Contains(from, `\`)) {
+		rest = strings.ReplaceAll(rest, "/", `\`)
+	} else {
+		rest = strings.ReplaceAll(rest, `\`, "/")
+	}
+
+	return to + rest, true
+}
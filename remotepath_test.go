@@ -0,0 +1,170 @@
+package radarr
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPathMapper(t *testing.T) {
+	mapper := NewPathMapper(
+		PathMapping{RadarrPath: "/movies", LocalPath: "/mnt/media/movies"},
+		PathMapping{RadarrPath: "/movies/4k", LocalPath: "/mnt/uhd"},
+		PathMapping{RadarrPath: `D:\Downloads`, LocalPath: "/mnt/downloads"},
+	)
+
+	tests := []struct {
+		radarr string
+		local  string
+	}{
+		{"/movies/The Matrix (1999)", "/mnt/media/movies/The Matrix (1999)"},
+		{"/movies", "/mnt/media/movies"},
+		{"/movies/4k/Dune (2021)", "/mnt/uhd/Dune (2021)"},
+		{`D:\Downloads\complete\Dune.2021.2160p`, "/mnt/downloads/complete/Dune.2021.2160p"},
+	}
+
+	for _, test := range tests {
+		if local := mapper.ToLocal(test.radarr); local != test.local {
+			t.Errorf("expected %s to map to %s, got %s", test.radarr, test.local, local)
+		}
+
+		if radarr := mapper.ToRadarr(test.local); radarr != test.radarr {
+			t.Errorf("expected %s to map back to %s, got %s", test.local, test.radarr, radarr)
+		}
+	}
+
+	// only whole folders match
+	for _, path := range []string{"/movies-old/Heat (1995)", "/tv/Lost", ""} {
+		if local := mapper.ToLocal(path); local != path {
+			t.Errorf("expected %s to be left alone, got %s", path, local)
+		}
+	}
+
+	movie := mapper.Movie(Movie{Path: "/movies/Heat (1995)", RootFolderPath: "/movies"})
+
+	if movie.Path != "/mnt/media/movies/Heat (1995)" || movie.RootFolderPath != "/mnt/media/movies" {
+		t.Errorf("expected movie paths to be translated, got %s and %s", movie.Path, movie.RootFolderPath)
+	}
+}
+
+func TestRemotePathMappings(t *testing.T) {
+	var requests []string
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/api/remotepathmapping", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		if r.Method != "POST" {
+			w.Write([]byte(`[{"host":"sabnzbd","remotePath":"/downloads/","localPath":"/mnt/downloads/","id":2}]`))
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+
+		if string(body) != `{"host":"qbittorrent","remotePath":"/data/","localPath":"/mnt/torrents/"}` {
+			t.Errorf("unexpected create payload: %s", body)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"host":"qbittorrent","remotePath":"/data/","localPath":"/mnt/torrents/","id":3}`))
+	})
+
+	mux.HandleFunc("/api/remotepathmapping/2", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		if r.Method == "DELETE" {
+			return
+		}
+
+		var mapping RemotePathMapping
+
+		if err := json.NewDecoder(r.Body).Decode(&mapping); err != nil {
+			t.Error(err)
+		}
+
+		if mapping.ID != 2 || mapping.LocalPath != "/srv/downloads/" {
+			t.Errorf("unexpected update: %+v", mapping)
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+		json.NewEncoder(w).Encode(mapping)
+	})
+
+	mux.HandleFunc("/api/remotepathmapping/7", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := New(server.URL, "abc123")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mappings, err := client.GetRemotePathMappings()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(mappings) != 1 || mappings[0].ID != 2 || mappings[0].Host != "sabnzbd" {
+		t.Fatalf("unexpected mappings: %+v", mappings)
+	}
+
+	created, err := client.CreateRemotePathMapping(RemotePathMapping{ID: 8, Host: "qbittorrent", RemotePath: "/data/", LocalPath: "/mnt/torrents/"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if created.ID != 3 {
+		t.Errorf("expected the created mapping's id, got %+v", created)
+	}
+
+	mapping := mappings[0]
+	mapping.LocalPath = "/srv/downloads/"
+
+	if _, err := client.UpdateRemotePathMapping(mapping); err != nil {
+		t.Error(err)
+	}
+
+	if err := client.DeleteRemotePathMapping(2); err != nil {
+		t.Error(err)
+	}
+
+	if _, err := client.UpdateRemotePathMapping(RemotePathMapping{ID: 7, Host: "nzbget", RemotePath: "/a/", LocalPath: "/b/"}); err == nil || err.Error() != "404 Not Found" {
+		t.Errorf("expected the response status as the error, got %v", err)
+	}
+
+	if err := client.DeleteRemotePathMapping(7); err == nil || err.Error() != "404 Not Found" {
+		t.Errorf("expected the response status as the error, got %v", err)
+	}
+
+	// invalid mappings are rejected before any request
+	if _, err := client.CreateRemotePathMapping(RemotePathMapping{Host: "sabnzbd", RemotePath: "/downloads/"}); err == nil {
+		t.Error("expected a mapping without a local path to return an error")
+	}
+
+	if _, err := client.UpdateRemotePathMapping(RemotePathMapping{Host: "sabnzbd", RemotePath: "/a/", LocalPath: "/b/"}); err == nil {
+		t.Error("expected a mapping without an id to return an error")
+	}
+
+	expected := []string{"GET /api/remotepathmapping", "POST /api/remotepathmapping", "PUT /api/remotepathmapping/2", "DELETE /api/remotepathmapping/2", "PUT /api/remotepathmapping/7", "DELETE /api/remotepathmapping/7"}
+
+	if len(requests) != len(expected) {
+		t.Fatalf("expected requests %v, got %v", expected, requests)
+	}
+
+	for i := range expected {
+		if requests[i] != expected[i] {
+			t.Errorf("expected requests %v, got %v", expected, requests)
+			break
+		}
+	}
+}
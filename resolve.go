@@ -0,0 +1,185 @@
+package radarr
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Resolution the movie a reference resolved to
+type Resolution struct {
+	Movie Movie
+	// Confidence how sure the match is, from 0 to 1. Id references that were
+	// found are always 1, titles get the score of the best matching title
+	Confidence float64
+	// InLibrary whether the movie came from the library rather than a lookup
+	InLibrary bool
+}
+
+// ErrorMovieNotFound error when a reference doesn't match any movie
+var ErrorMovieNotFound = errors.New("no movie matches the reference")
+
+var (
+	imdbIDRe    = regexp.MustCompile(`^tt\d{7,}$`)
+	imdbRefRe   = regexp.MustCompile(`(?i)^(?:imdb:)?(tt\d{7,})$`)
+	imdbURLRe   = regexp.MustCompile(`(?i)imdb\.com/(?:[a-z]{2}/)?title/(tt\d{7,})`)
+	tmdbRefRe   = regexp.MustCompile(`(?i)^tmdb:(\d+)$`)
+	tmdbURLRe   = regexp.MustCompile(`(?i)themoviedb\.org/movie/(\d+)`)
+	titleYearRe = regexp.MustCompile(`^(.+?)\s*[(\[]((?:18|19|20)\d{2})[)\]]$`)
+)
+
+// resolveThreshold library title matches scoring below it fall back to a lookup
+const resolveThreshold = 0.9
+
+// Resolve finds the movie behind a reference, which can be
+//   - a tmdb id, e.g. tmdb:603
+//   - an imdb id, e.g. tt0133093 or imdb:tt0133093
+//   - an imdb or tmdb url, e.g. https://www.themoviedb.org/movie/603-the-matrix
+//   - a title with an optional year, e.g. The Matrix (1999)
+//
+// The library is checked first and radarr's lookup is used when the movie
+// isn't in it, or for titles when no library movie matches well enough.
+// This fetches the whole library, use MovieIndex.Resolve when resolving
+// repeatedly. ErrorMovieNotFound is returned when nothing matches
+func (c Client) Resolve(ref string) (Resolution, error) {
+	index, err := c.IndexLibrary()
+
+	if err != nil {
+		return Resolution{}, err
+	}
+
+	return index.Resolve(c, ref)
+}
+
+// Resolve is like Client.Resolve but checks the index instead of fetching
+// the library, and only looks up movies that aren't in it
+func (i *MovieIndex) Resolve(c Client, ref string) (Resolution, error) {
+	return c.resolve(ref, i)
+}
+
+func (c Client) resolve(ref string, index *MovieIndex) (Resolution, error) {
+	var resolution Resolution
+
+	ref = strings.TrimSpace(ref)
+
+	if ref == "" {
+		return resolution, errors.New("reference is required")
+	}
+
+	if imdbID := matchRef(ref, imdbRefRe, imdbURLRe); imdbID != "" {
+		imdbID = strings.ToLower(imdbID)
+
+		if movie, ok := index.ByImdbID(imdbID); ok {
+			return Resolution{Movie: movie, Confidence: 1, InLibrary: true}, nil
+		}
+
+		return resolveLookup(c.GetMovieIMDB(imdbID))
+	}
+
+	if tmdbRef := matchRef(ref, tmdbRefRe, tmdbURLRe); tmdbRef != "" {
+		tmdbID, err := strconv.Atoi(tmdbRef)
+
+		if err != nil {
+			return resolution, err
+		}
+
+		if movie, ok := index.ByTmdbID(tmdbID); ok {
+			return Resolution{Movie: movie, Confidence: 1, InLibrary: true}, nil
+		}
+
+		return resolveLookup(c.GetMovie(tmdbID))
+	}
+
+	return c.resolveTitle(index, ref)
+}
+
+// matchRef returns the first submatch of the first pattern matching ref
+func matchRef(ref string, patterns ...*regexp.Regexp) string {
+	for _, pattern := range patterns {
+		if match := pattern.FindStringSubmatch(ref); match != nil {
+			return match[1]
+		}
+	}
+
+	return ""
+}
+
+func resolveLookup(movie Movie, err error) (Resolution, error) {
+	if err != nil {
+		return Resolution{}, err
+	}
+
+	// radarr answers unknown ids with an empty movie
+	if movie.TmdbID == 0 {
+		return Resolution{}, ErrorMovieNotFound
+	}
+
+	return Resolution{Movie: movie, Confidence: 1}, nil
+}
+
+func (c Client) resolveTitle(library *MovieIndex, ref string) (Resolution, error) {
+	title, year := ref, 0
+
+	if match := titleYearRe.FindStringSubmatch(ref); match != nil {
+		title = match[1]
+		year, _ = strconv.Atoi(match[2])
+	}
+
+	best, err := bestMatch(library, title, year)
+
+	if err != nil {
+		return Resolution{}, err
+	}
+
+	if best.Score >= resolveThreshold {
+		return Resolution{Movie: best.Movie, Confidence: best.Score, InLibrary: true}, nil
+	}
+
+	results, err := c.Search(title)
+
+	if err != nil {
+		return Resolution{}, err
+	}
+
+	found, err := bestMatch(NewMovieIndex(results), title, year)
+
+	if err != nil {
+		return Resolution{}, err
+	}
+
+	if found.Score > best.Score {
+		return Resolution{Movie: found.Movie, Confidence: found.Score}, nil
+	}
+
+	if best.Score > 0 {
+		return Resolution{Movie: best.Movie, Confidence: best.Score, InLibrary: true}, nil
+	}
+
+	return Resolution{}, ErrorMovieNotFound
+}
+
+// bestMatch the best search result for title, preferring year when it is
+// set. A match from another year still counts but with less confidence
+func bestMatch(index *MovieIndex, title string, year int) (SearchResult, error) {
+	results, err := index.Search(title, OfflineSearchOptions{Year: year, Limit: 1})
+
+	if err != nil || len(results) > 0 || year == 0 {
+		return firstResult(results), err
+	}
+
+	results, err = index.Search(title, OfflineSearchOptions{Limit: 1})
+
+	best := firstResult(results)
+	best.Score *= 0.8
+
+	return best, err
+}
+
+func firstResult(results []SearchResult) SearchResult {
+	if len(results) == 0 {
+		return SearchResult{}
+	}
+
+	return results[0]
+}
@@ -0,0 +1,136 @@
+package radarr
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const resolveBladeRunner = `{"title":"Blade Runner","year":1982,"tmdbId":78,"imdbId":"tt0083658"}`
+
+// resolveServer serves a library with just the matrix and counts how often
+// the library is fetched. Library movies must be found without a lookup
+func resolveServer(t *testing.T, libraryFetches *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+
+		switch r.URL.Path {
+		case "/api/movie":
+			*libraryFetches++
+			w.Write([]byte(`[{"id":1,"title":"The Matrix","sortTitle":"matrix","cleanTitle":"thematrix","year":1999,"tmdbId":603,"imdbId":"tt0133093"}]`))
+		case "/api/movie/lookup/tmdb":
+			switch query.Get("tmdbId") {
+			case "78":
+				w.Write([]byte(resolveBladeRunner))
+			default:
+				w.Write([]byte(`{}`))
+			}
+		case "/api/movie/lookup/imdb":
+			switch query.Get("imdbId") {
+			case "tt0083658":
+				w.Write([]byte(resolveBladeRunner))
+			default:
+				t.Errorf("unexpected imdb id: %s", r.URL.RawQuery)
+				w.WriteHeader(http.StatusNotFound)
+			}
+		case "/api/movie/lookup":
+			w.Write([]byte(`[{"title":"Blade Runner 2049","year":2017,"tmdbId":335984},{"title":"Blade Runner","year":1982,"tmdbId":78}]`))
+		default:
+			t.Errorf("unexpected request: %s", r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestResolve(t *testing.T) {
+	var libraryFetches int
+
+	server := resolveServer(t, &libraryFetches)
+	defer server.Close()
+
+	client, err := New(server.URL, "abc123")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		ref        string
+		tmdbID     int
+		inLibrary  bool
+		confidence float64
+	}{
+		{"tmdb:603", 603, true, 1},
+		{"tt0133093", 603, true, 1},
+		{"https://www.imdb.com/title/tt0133093/", 603, true, 1},
+		{"https://www.themoviedb.org/movie/603-the-matrix", 603, true, 1},
+		{"The Matrix (1999)", 603, true, 1},
+		{"the matrix", 603, true, 1},
+		{"TMDB:78", 78, false, 1},
+		{"imdb:tt0083658", 78, false, 1},
+		{"Blade Runner (1982)", 78, false, 1},
+	}
+
+	for _, test := range tests {
+		libraryFetches = 0
+
+		resolution, err := client.Resolve(test.ref)
+
+		if err != nil {
+			t.Errorf("resolve %q: %v", test.ref, err)
+			continue
+		}
+
+		if resolution.Movie.TmdbID != test.tmdbID || resolution.InLibrary != test.inLibrary || resolution.Confidence != test.confidence {
+			t.Errorf("resolve %q: unexpected resolution %d in library %v confidence %v", test.ref, resolution.Movie.TmdbID, resolution.InLibrary, resolution.Confidence)
+		}
+
+		if libraryFetches != 1 {
+			t.Errorf("resolve %q: fetched the library %d times", test.ref, libraryFetches)
+		}
+	}
+
+	if _, err := client.Resolve("tmdb:1"); err != ErrorMovieNotFound {
+		t.Errorf("expected an unknown tmdb id to return ErrorMovieNotFound, got %v", err)
+	}
+
+	if _, err := client.GetMovieIMDB("0133093"); err == nil {
+		t.Error("expected an imdb id without the tt prefix to return an error")
+	}
+}
+
+func TestMovieIndexResolve(t *testing.T) {
+	var libraryFetches int
+
+	server := resolveServer(t, &libraryFetches)
+	defer server.Close()
+
+	client, err := New(server.URL, "abc123")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	index, err := client.IndexLibrary()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, ref := range []string{"tmdb:603", "tt0133093", "The Matrix", "tmdb:78", "Blade Runner (1982)"} {
+		resolution, err := index.Resolve(client, ref)
+
+		if err != nil {
+			t.Errorf("resolve %q: %v", ref, err)
+			continue
+		}
+
+		if resolution.InLibrary != (resolution.Movie.TmdbID == 603) {
+			t.Errorf("resolve %q: unexpected resolution %d in library %v", ref, resolution.Movie.TmdbID, resolution.InLibrary)
+		}
+	}
+
+	if libraryFetches != 1 {
+		t.Errorf("expected the library to only be fetched for the index, got %d fetches", libraryFetches)
+	}
+}
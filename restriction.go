@@ -0,0 +1,242 @@
+package radarr
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// Restriction terms a release must or must not contain, for movies with any
+// of the tags -- restrictions without tags apply to every movie
+type Restriction struct {
+	ID int `json:"id,omitempty"`
+	// Required comma separated terms, a release must contain at least one
+	Required string `json:"required"`
+	// Ignored comma separated terms, a release must contain none
+	Ignored string `json:"ignored"`
+	Tags    []int  `json:"tags"`
+
+	Unknown
+}
+
+// UnmarshalJSON decodes the restriction and keeps the fields it doesn't model in Extra
+func (r *Restriction) UnmarshalJSON(data []byte) error {
+	type plain Restriction
+
+	return unmarshalUnknown(data, (*plain)(r), &r.Unknown)
+}
+
+// MarshalJSON encodes the restriction with Extra merged back in
+func (r Restriction) MarshalJSON() ([]byte, error) {
+	type plain Restriction
+
+	return marshalUnknown(plain(r), r.Unknown)
+}
+
+// RequiredTerms the required terms split on commas
+func (r Restriction) RequiredTerms() []string {
+	return splitTerms(r.Required)
+}
+
+// IgnoredTerms the ignored terms split on commas
+func (r Restriction) IgnoredTerms() []string {
+	return splitTerms(r.Ignored)
+}
+
+// AppliesTo reports if radarr would apply the restriction to a movie with tags
+func (r Restriction) AppliesTo(tags []int) bool {
+	if len(r.Tags) == 0 {
+		return true
+	}
+
+	for _, tag := range r.Tags {
+		for _, movieTag := range tags {
+			if tag == movieTag {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Matches applies the required and ignored terms to a release title the way
+// radarr does -- terms are matched case insensitively anywhere in the title
+// and terms wrapped in slashes, e.g. /\bCAM\b/i, are regular expressions.
+// A term that isn't a valid go regex never matches
+func (r Restriction) Matches(releaseTitle string) bool {
+	required := r.RequiredTerms()
+
+	if len(required) > 0 {
+		found := false
+
+		for _, term := range required {
+			if termMatches(term, releaseTitle) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	for _, term := range r.IgnoredTerms() {
+		if termMatches(term, releaseTitle) {
+			return false
+		}
+	}
+
+	return true
+}
+
+var termRegexRe = regexp.MustCompile(`^/(.+)/([a-z]*)$`)
+
+func termMatches(term, title string) bool {
+	if match := termRegexRe.FindStringSubmatch(term); match != nil {
+		pattern := match[1]
+
+		if strings.Contains(match[2], "i") {
+			pattern = "(?i)" + pattern
+		}
+
+		re, err := regexp.Compile(pattern)
+
+		if err != nil {
+			return false
+		}
+
+		return re.MatchString(title)
+	}
+
+	return strings.Contains(strings.ToLower(title), strings.ToLower(term))
+}
+
+func splitTerms(terms string) []string {
+	var split []string
+
+	for _, term := range strings.Split(terms, ",") {
+		if term = strings.TrimSpace(term); term != "" {
+			split = append(split, term)
+		}
+	}
+
+	return split
+}
+
+// GetRestrictions returns every restriction
+func (c Client) GetRestrictions() ([]Restriction, error) {
+	const endpoint = "/api/restriction"
+	var restrictions []Restriction
+
+	resp, err := c.get(endpoint, nil)
+
+	if err != nil {
+		return restrictions, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return restrictions, errors.New(resp.Status)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&restrictions)
+
+	return restrictions, err
+}
+
+// CreateRestriction adds a restriction and returns it with its new id
+func (c Client) CreateRestriction(restriction Restriction) (Restriction, error) {
+	const endpoint = "/api/restriction"
+
+	if restriction.Required == "" && restriction.Ignored == "" {
+		return restriction, errors.New("either required or ignored terms are required")
+	}
+
+	restriction.ID = 0
+
+	requestPayload, err := json.Marshal(restriction)
+
+	if err != nil {
+		return restriction, err
+	}
+
+	resp, err := c.post(endpoint, requestPayload)
+
+	if err != nil {
+		return restriction, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return restriction, errors.New(resp.Status)
+	}
+
+	var created Restriction
+
+	err = json.NewDecoder(resp.Body).Decode(&created)
+
+	return created, err
+}
+
+// UpdateRestriction replaces the restriction with the same id
+func (c Client) UpdateRestriction(restriction Restriction) (Restriction, error) {
+	const endpoint = "/api/restriction/%d"
+
+	if restriction.ID == 0 {
+		return restriction, errors.New("id is required")
+	}
+
+	if restriction.Required == "" && restriction.Ignored == "" {
+		return restriction, errors.New("either required or ignored terms are required")
+	}
+
+	requestPayload, err := json.Marshal(restriction)
+
+	if err != nil {
+		return restriction, err
+	}
+
+	resp, err := c.put(fmt.Sprintf(endpoint, restriction.ID), requestPayload)
+
+	if err != nil {
+		return restriction, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusAccepted && resp.StatusCode != http.StatusOK {
+		return restriction, errors.New(resp.Status)
+	}
+
+	var updated Restriction
+
+	err = json.NewDecoder(resp.Body).Decode(&updated)
+
+	return updated, err
+}
+
+// DeleteRestriction removes a restriction
+func (c Client) DeleteRestriction(id int) error {
+	const endpoint = "/api/restriction/%d"
+
+	resp, err := c.delete(fmt.Sprintf(endpoint, id), nil)
+
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return errors.New(resp.Status)
+	}
+
+	return nil
+}
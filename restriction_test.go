@@ -0,0 +1,164 @@
+package radarr
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRestrictionMatches(t *testing.T) {
+	restriction := Restriction{
+		Required: "x264, x265",
+		Ignored:  `CAM, /\bTS\b/i, /(YIFY|YTS)/`,
+	}
+
+	tests := []struct {
+		title   string
+		matches bool
+	}{
+		{"The.Matrix.1999.1080p.BluRay.x264-SPARKS", true},
+		{"The.Matrix.1999.1080p.BluRay.X265-NOGRP", true},
+		{"The.Matrix.1999.1080p.BluRay.AVC-NOGRP", false},
+		{"The.Matrix.1999.HDCAM.x264-NOGRP", false},
+		{"The.Matrix.1999.ts.x264-NOGRP", false},
+		{"The.Matrix.1999.1080p.BluRay.x264-YIFY", false},
+		// regex terms without the i flag are case sensitive
+		{"The.Matrix.1999.1080p.BluRay.x264-yify", true},
+	}
+
+	for _, test := range tests {
+		if matches := restriction.Matches(test.title); matches != test.matches {
+			t.Errorf("%s: expected %v, got %v", test.title, test.matches, matches)
+		}
+	}
+
+	if !(Restriction{}).Matches("anything") {
+		t.Error("expected an empty restriction to match everything")
+	}
+
+	if !(Restriction{}).AppliesTo([]int{1}) || (Restriction{Tags: []int{2}}).AppliesTo([]int{1}) {
+		t.Error("expected restrictions without tags to apply to every movie and tagged ones only to their tags")
+	}
+}
+
+func TestRestrictions(t *testing.T) {
+	var requests []string
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/api/restriction", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		if r.Method != "POST" {
+			w.Write([]byte(`[{"required":"x264","ignored":"cam","tags":[1],"id":4}]`))
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+
+		if string(body) != `{"required":"x265","ignored":"","tags":[2]}` {
+			t.Errorf("unexpected create payload: %s", body)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"required":"x265","ignored":"","tags":[2],"id":5}`))
+	})
+
+	mux.HandleFunc("/api/restriction/4", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		if r.Method == "DELETE" {
+			return
+		}
+
+		var restriction Restriction
+
+		if err := json.NewDecoder(r.Body).Decode(&restriction); err != nil {
+			t.Error(err)
+		}
+
+		if restriction.ID != 4 || restriction.Ignored != "cam,ts" {
+			t.Errorf("unexpected update: %+v", restriction)
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+		json.NewEncoder(w).Encode(restriction)
+	})
+
+	mux.HandleFunc("/api/restriction/6", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := New(server.URL, "abc123")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	restrictions, err := client.GetRestrictions()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(restrictions) != 1 || restrictions[0].ID != 4 || restrictions[0].Required != "x264" {
+		t.Fatalf("unexpected restrictions: %+v", restrictions)
+	}
+
+	created, err := client.CreateRestriction(Restriction{ID: 9, Required: "x265", Tags: []int{2}})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if created.ID != 5 {
+		t.Errorf("expected the created restriction's id, got %+v", created)
+	}
+
+	restriction := restrictions[0]
+	restriction.Ignored = "cam,ts"
+
+	if _, err := client.UpdateRestriction(restriction); err != nil {
+		t.Error(err)
+	}
+
+	if err := client.DeleteRestriction(4); err != nil {
+		t.Error(err)
+	}
+
+	if _, err := client.UpdateRestriction(Restriction{ID: 6, Required: "x264"}); err == nil || err.Error() != "404 Not Found" {
+		t.Errorf("expected the response status as the error, got %v", err)
+	}
+
+	if err := client.DeleteRestriction(6); err == nil || err.Error() != "404 Not Found" {
+		t.Errorf("expected the response status as the error, got %v", err)
+	}
+
+	// invalid restrictions are rejected before any request
+	if _, err := client.CreateRestriction(Restriction{Tags: []int{1}}); err == nil {
+		t.Error("expected a restriction without terms to return an error")
+	}
+
+	if _, err := client.UpdateRestriction(Restriction{Required: "x264"}); err == nil {
+		t.Error("expected a restriction without an id to return an error")
+	}
+
+	expected := []string{"GET /api/restriction", "POST /api/restriction", "PUT /api/restriction/4", "DELETE /api/restriction/4", "PUT /api/restriction/6", "DELETE /api/restriction/6"}
+
+	if len(requests) != len(expected) {
+		t.Fatalf("expected requests %v, got %v", expected, requests)
+	}
+
+	for i := range expected {
+		if requests[i] != expected[i] {
+			t.Errorf("expected requests %v, got %v", expected, requests)
+			break
+		}
+	}
+}
@@ -0,0 +1,372 @@
+package radarr
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/jrudio/go-radarr-client/release"
+)
+
+// radarr's enum values used by the source, resolution and quality modifier
+// custom format specifications
+var (
+	specSources = map[release.Source]int{
+		release.SourceUnknown:  0,
+		release.SourceCam:      1,
+		release.SourceTelesync: 2,
+		release.SourceTelecine: 3,
+		release.SourceDVD:      5,
+		release.SourceHDTV:     6,
+		release.SourceWEBDL:    7,
+		release.SourceWEBRip:   8,
+		release.SourceBluRay:   9,
+	}
+
+	specModifierScreener = 2
+	specModifierRemux    = 5
+)
+
+// Evaluation how a release name scores against a quality profile and its
+// custom formats
+type Evaluation struct {
+	Title   string
+	Release release.Info
+	// Quality the radarr quality name of the release, e.g. Bluray-1080p
+	Quality string
+	// QualityRank position of Quality in the profile items, higher is better
+	// and -1 when the profile doesn't know the quality
+	QualityRank    int
+	QualityAllowed bool
+	// Formats names of the custom formats the release matched
+	Formats []string
+	Score   int
+	// Revision is 2 for propers and repacks
+	Revision int
+	// Rejections reasons the release would not be grabbed
+	Rejections []string
+}
+
+// Accepted the release would be grabbed if nothing better came along
+func (e Evaluation) Accepted() bool {
+	return len(e.Rejections) == 0
+}
+
+type compiledSpec struct {
+	CustomFormatSpecification
+	re    *regexp.Regexp
+	value int
+}
+
+type compiledFormat struct {
+	format CustomFormat
+	score  int
+	specs  []compiledSpec
+}
+
+// Evaluator scores release names offline the way radarr would with the given
+// quality profile and custom formats, so format changes can be tested
+// against historic release names without waiting on radarr to grab them
+type Evaluator struct {
+	Profile Profile
+	// Unsupported specifications that were skipped, either because their
+	// implementation can't be evaluated from a name, e.g. languages, or because
+	// their regex uses syntax go doesn't support, e.g. lookarounds
+	Unsupported []string
+
+	formats    []compiledFormat
+	cutoffRank int
+}
+
+// NewEvaluator prepares formats to be scored with profile
+func NewEvaluator(profile Profile, formats []CustomFormat) *Evaluator {
+	e := &Evaluator{
+		Profile: profile,
+		// a cutoff missing from the items means only the best quality will do
+		cutoffRank: len(profile.Items) - 1,
+	}
+
+	for i, item := range profile.Items {
+		if item.Quality.ID == profile.Cutoff.ID {
+			e.cutoffRank = i
+		}
+	}
+
+	for _, format := range formats {
+		compiled := compiledFormat{
+			format: format,
+			score:  formatScore(profile, format),
+		}
+
+		for _, spec := range format.Specifications {
+			s, err := compileSpec(spec)
+
+			if err != nil {
+				e.Unsupported = append(e.Unsupported, fmt.Sprintf("%s: %s: %v", format.Name, spec.Name, err))
+				continue
+			}
+
+			compiled.specs = append(compiled.specs, s)
+		}
+
+		e.formats = append(e.formats, compiled)
+	}
+
+	return e
+}
+
+func formatScore(profile Profile, format CustomFormat) int {
+	for _, item := range profile.FormatItems {
+		if (format.ID != 0 && item.Format == format.ID) || (item.Format == 0 && item.Name == format.Name) {
+			return item.Score
+		}
+	}
+
+	return 0
+}
+
+func compileSpec(spec CustomFormatSpecification) (compiledSpec, error) {
+	compiled := compiledSpec{CustomFormatSpecification: spec}
+	value := spec.FieldValue("value")
+
+	switch spec.Implementation {
+	case "ReleaseTitleSpecification", "ReleaseGroupSpecification", "EditionSpecification":
+		pattern, ok := value.(string)
+
+		if !ok || pattern == "" {
+			return compiled, fmt.Errorf("a regex value is required")
+		}
+
+		re, err := regexp.Compile("(?i)" + pattern)
+
+		if err != nil {
+			return compiled, err
+		}
+
+		compiled.re = re
+	case "ResolutionSpecification", "SourceSpecification", "QualityModifierSpecification":
+		number, ok := intValue(value)
+
+		if !ok {
+			return compiled, fmt.Errorf("a numeric value is required")
+		}
+
+		compiled.value = number
+	default:
+		return compiled, fmt.Errorf("%s is not supported", spec.Implementation)
+	}
+
+	return compiled, nil
+}
+
+func intValue(value interface{}) (int, bool) {
+	switch v := value.(type) {
+	case float64:
+		return int(v), true
+	case int:
+		return v, true
+	case string:
+		number, err := strconv.Atoi(v)
+		return number, err == nil
+	}
+
+	return 0, false
+}
+
+// matches reports if a single specification is satisfied, negate included
+func (s compiledSpec) matches(title string, info release.Info) bool {
+	var matched bool
+
+	switch s.Implementation {
+	case "ReleaseTitleSpecification":
+		matched = s.re.MatchString(title)
+	case "ReleaseGroupSpecification":
+		matched = info.Group != "" && s.re.MatchString(info.Group)
+	case "EditionSpecification":
+		matched = info.Edition != "" && s.re.MatchString(info.Edition)
+	case "ResolutionSpecification":
+		matched = strings.TrimSuffix(info.Resolution, "p") == strconv.Itoa(s.value)
+	case "SourceSpecification":
+		matched = specSources[info.Source] == s.value
+	case "QualityModifierSpecification":
+		matched = (s.value == specModifierRemux && info.Remux) ||
+			(s.value == specModifierScreener && info.Source == release.SourceScreener)
+	}
+
+	if s.Negate {
+		return !matched
+	}
+
+	return matched
+}
+
+// matches follows radarr -- specifications are grouped by implementation and
+// every group must match. A group fails when any required specification
+// fails or when none of its specifications match
+func (f compiledFormat) matches(title string, info release.Info) bool {
+	if len(f.specs) == 0 {
+		return false
+	}
+
+	type group struct {
+		any, requiredFailed bool
+	}
+
+	groups := map[string]*group{}
+
+	for _, spec := range f.specs {
+		g, ok := groups[spec.Implementation]
+
+		if !ok {
+			g = &group{}
+			groups[spec.Implementation] = g
+		}
+
+		matched := spec.matches(title, info)
+
+		if matched {
+			g.any = true
+		} else if spec.Required {
+			g.requiredFailed = true
+		}
+	}
+
+	for _, g := range groups {
+		if !g.any || g.requiredFailed {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Evaluate scores a release name
+func (e *Evaluator) Evaluate(title string) Evaluation {
+	info := release.Parse(title)
+
+	evaluation := Evaluation{
+		Title:       title,
+		Release:     info,
+		Quality:     QualityName(info),
+		QualityRank: -1,
+		Revision:    1,
+	}
+
+	if info.Proper || info.Repack {
+		evaluation.Revision = 2
+	}
+
+	for i, item := range e.Profile.Items {
+		if strings.EqualFold(item.Quality.Name, evaluation.Quality) {
+			evaluation.QualityRank = i
+			evaluation.QualityAllowed = item.Allowed
+		}
+	}
+
+	if !evaluation.QualityAllowed {
+		evaluation.Rejections = append(evaluation.Rejections, fmt.Sprintf("%s is not wanted in profile %s", evaluation.Quality, e.Profile.Name))
+	}
+
+	for _, format := range e.formats {
+		if format.matches(title, info) {
+			evaluation.Formats = append(evaluation.Formats, format.format.Name)
+			evaluation.Score += format.score
+		}
+	}
+
+	if evaluation.Score < e.Profile.MinFormatScore {
+		evaluation.Rejections = append(evaluation.Rejections, fmt.Sprintf("custom format score %d is below the minimum of %d", evaluation.Score, e.Profile.MinFormatScore))
+	}
+
+	return evaluation
+}
+
+// CutoffMet the release has reached both the cutoff quality and the cutoff
+// format score, so radarr would stop looking for upgrades
+func (e *Evaluator) CutoffMet(existing Evaluation) bool {
+	return existing.QualityRank >= e.cutoffRank && existing.Score >= e.Profile.CutoffFormatScore
+}
+
+// IsUpgrade decides if radarr would replace the existing release with the
+// candidate and explains why
+func (e *Evaluator) IsUpgrade(existing, candidate string) (bool, string) {
+	return e.Upgrade(e.Evaluate(existing), e.Evaluate(candidate))
+}
+
+// Upgrade is IsUpgrade for releases that were already evaluated
+func (e *Evaluator) Upgrade(existing, candidate Evaluation) (bool, string) {
+	if !candidate.Accepted() {
+		return false, strings.Join(candidate.Rejections, ", ")
+	}
+
+	if candidate.QualityRank < existing.QualityRank {
+		return false, fmt.Sprintf("%s is a lower quality than %s", candidate.Quality, existing.Quality)
+	}
+
+	if candidate.QualityRank > existing.QualityRank {
+		if existing.QualityRank >= e.cutoffRank {
+			return false, fmt.Sprintf("%s already meets the cutoff %s", existing.Quality, e.Profile.Cutoff.Name)
+		}
+
+		return true, fmt.Sprintf("%s is a better quality than %s", candidate.Quality, existing.Quality)
+	}
+
+	if candidate.Revision > existing.Revision {
+		return true, fmt.Sprintf("%s is a proper or repack of the same quality", candidate.Quality)
+	}
+
+	if candidate.Score > existing.Score {
+		if e.CutoffMet(existing) {
+			return false, fmt.Sprintf("score %d already meets the cutoff score %d", existing.Score, e.Profile.CutoffFormatScore)
+		}
+
+		return true, fmt.Sprintf("custom format score %d is higher than %d", candidate.Score, existing.Score)
+	}
+
+	return false, fmt.Sprintf("%s with score %d is not better than the existing release", candidate.Quality, candidate.Score)
+}
+
+// QualityName maps a parsed release to radarr's quality names, e.g. Bluray-1080p
+func QualityName(info release.Info) string {
+	resolution := info.Resolution
+
+	switch {
+	case info.Remux:
+		if resolution != "2160p" {
+			resolution = "1080p"
+		}
+
+		return "Remux-" + resolution
+	case info.Source == release.SourceBluRay:
+		if resolution == "" {
+			resolution = "720p"
+		}
+
+		return "Bluray-" + resolution
+	case info.Source == release.SourceWEBDL, info.Source == release.SourceWEBRip:
+		if resolution == "" || resolution == "576p" {
+			resolution = "480p"
+		}
+
+		return string(info.Source) + "-" + resolution
+	case info.Source == release.SourceHDTV:
+		if resolution == "" || resolution == "480p" || resolution == "576p" {
+			return "SDTV"
+		}
+
+		return "HDTV-" + resolution
+	case info.Source == release.SourceDVD:
+		return "DVD"
+	case info.Source == release.SourceScreener:
+		return "DVDSCR"
+	case info.Source == release.SourceCam, info.Source == release.SourceTelesync, info.Source == release.SourceTelecine:
+		return string(info.Source)
+	case resolution == "2160p", resolution == "1080p", resolution == "720p":
+		return "HDTV-" + resolution
+	case resolution != "":
+		return "SDTV"
+	}
+
+	return "Unknown"
+}
@@ -0,0 +1,149 @@
+package radarr
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+const testProfile = `{
+	"id": 1,
+	"name": "HD-1080p",
+	"cutoff": {"id": 7, "name": "Bluray-1080p"},
+	"items": [
+		{"allowed": false, "quality": {"id": 0, "name": "Unknown"}},
+		{"allowed": false, "quality": {"id": 24, "name": "CAM"}},
+		{"allowed": false, "quality": {"id": 2, "name": "DVD"}},
+		{"allowed": true, "quality": {"id": 4, "name": "HDTV-720p"}},
+		{"allowed": true, "quality": {"id": 14, "name": "WEBRip-720p"}},
+		{"allowed": true, "quality": {"id": 5, "name": "WEBDL-720p"}},
+		{"allowed": true, "quality": {"id": 6, "name": "Bluray-720p"}},
+		{"allowed": true, "quality": {"id": 15, "name": "WEBRip-1080p"}},
+		{"allowed": true, "quality": {"id": 3, "name": "WEBDL-1080p"}},
+		{"allowed": true, "quality": {"id": 7, "name": "Bluray-1080p"}},
+		{"allowed": false, "quality": {"id": 30, "name": "Remux-1080p"}},
+		{"allowed": false, "quality": {"id": 19, "name": "Bluray-2160p"}}
+	],
+	"formatItems": [
+		{"format": 1, "name": "x265", "score": -100},
+		{"format": 2, "name": "Tier 1 Group", "score": 50},
+		{"format": 3, "name": "Bad Group", "score": -10000},
+		{"format": 4, "name": "1080p BluRay", "score": 20},
+		{"format": 5, "name": "Not Remux", "score": 5}
+	],
+	"minFormatScore": 0,
+	"cutoffFormatScore": 50
+}`
+
+const testFormats = `[
+	{"id": 1, "name": "x265", "specifications": [
+		{"name": "x265", "implementation": "ReleaseTitleSpecification", "fields": [{"name": "value", "value": "[xh][ ._-]?265|\\bHEVC(\\b|\\d)"}]}
+	]},
+	{"id": 2, "name": "Tier 1 Group", "specifications": [
+		{"name": "CtrlHD", "implementation": "ReleaseGroupSpecification", "fields": [{"name": "value", "value": "^CtrlHD$"}]},
+		{"name": "DON", "implementation": "ReleaseGroupSpecification", "fields": [{"name": "value", "value": "^DON$"}]}
+	]},
+	{"id": 3, "name": "Bad Group", "specifications": [
+		{"name": "YIFY", "implementation": "ReleaseGroupSpecification", "fields": [{"name": "value", "value": "^(YIFY|YTS)$"}]}
+	]},
+	{"id": 4, "name": "1080p BluRay", "specifications": [
+		{"name": "1080p", "implementation": "ResolutionSpecification", "required": true, "fields": [{"name": "value", "value": 1080}]},
+		{"name": "BluRay", "implementation": "SourceSpecification", "required": true, "fields": [{"name": "value", "value": 9}]}
+	]},
+	{"id": 5, "name": "Not Remux", "specifications": [
+		{"name": "Remux", "implementation": "QualityModifierSpecification", "negate": true, "fields": [{"name": "value", "value": 5}]}
+	]},
+	{"id": 6, "name": "Lookahead", "specifications": [
+		{"name": "HDR10+", "implementation": "ReleaseTitleSpecification", "fields": [{"name": "value", "value": "(?<=HDR10)\\+"}]},
+		{"name": "French", "implementation": "LanguageSpecification", "fields": [{"name": "value", "value": 2}]}
+	]}
+]`
+
+func newTestEvaluator(t *testing.T) *Evaluator {
+	var profile Profile
+	var formats []CustomFormat
+
+	if err := json.Unmarshal([]byte(testProfile), &profile); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := json.Unmarshal([]byte(testFormats), &formats); err != nil {
+		t.Fatal(err)
+	}
+
+	return NewEvaluator(profile, formats)
+}
+
+func TestEvaluate(t *testing.T) {
+	evaluator := newTestEvaluator(t)
+
+	if len(evaluator.Unsupported) != 2 {
+		t.Errorf("expected the lookahead and language specifications to be unsupported, got %v", evaluator.Unsupported)
+	}
+
+	tests := []struct {
+		title    string
+		quality  string
+		formats  []string
+		score    int
+		accepted bool
+	}{
+		{"Heat.1995.1080p.BluRay.x264-CtrlHD", "Bluray-1080p", []string{"Tier 1 Group", "1080p BluRay", "Not Remux"}, 75, true},
+		{"Heat.1995.1080p.BluRay.x265-NOGRP", "Bluray-1080p", []string{"x265", "1080p BluRay", "Not Remux"}, -75, false},
+		{"Heat.1995.1080p.BluRay.x264-YIFY", "Bluray-1080p", []string{"Bad Group", "1080p BluRay", "Not Remux"}, -9975, false},
+		{"Heat.1995.720p.WEB-DL.DD5.1.H.264-NOGRP", "WEBDL-720p", []string{"Not Remux"}, 5, true},
+		{"Heat.1995.1080p.BluRay.REMUX.AVC.DTS-HD.MA.5.1-NOGRP", "Remux-1080p", []string{"1080p BluRay"}, 20, false},
+		{"Heat.1995.HDCAM.x264-NOGRP", "CAM", []string{"Not Remux"}, 5, false},
+	}
+
+	for _, test := range tests {
+		evaluation := evaluator.Evaluate(test.title)
+
+		if evaluation.Quality != test.quality {
+			t.Errorf("%s: expected quality %s, got %s", test.title, test.quality, evaluation.Quality)
+		}
+
+		if !reflect.DeepEqual(evaluation.Formats, test.formats) {
+			t.Errorf("%s: expected formats %v, got %v", test.title, test.formats, evaluation.Formats)
+		}
+
+		if evaluation.Score != test.score {
+			t.Errorf("%s: expected score %d, got %d", test.title, test.score, evaluation.Score)
+		}
+
+		if evaluation.Accepted() != test.accepted {
+			t.Errorf("%s: expected accepted to be %v, rejections: %v", test.title, test.accepted, evaluation.Rejections)
+		}
+	}
+}
+
+func TestIsUpgrade(t *testing.T) {
+	evaluator := newTestEvaluator(t)
+
+	tests := []struct {
+		existing  string
+		candidate string
+		upgrade   bool
+	}{
+		// better quality below the cutoff
+		{"Heat.1995.720p.WEB-DL.DD5.1.H.264-NOGRP", "Heat.1995.1080p.BluRay.x264-NOGRP", true},
+		// lower quality
+		{"Heat.1995.1080p.BluRay.x264-NOGRP", "Heat.1995.720p.BluRay.x264-CtrlHD", false},
+		// same quality, better score below the cutoff score
+		{"Heat.1995.1080p.BluRay.x264-NOGRP", "Heat.1995.1080p.BluRay.x264-CtrlHD", true},
+		// same quality, the existing release already meets the cutoff score
+		{"Heat.1995.1080p.BluRay.x264-CtrlHD", "Heat.1995.1080p.BluRay.x264-DON", false},
+		// proper of the same quality
+		{"Heat.1995.720p.WEB-DL.DD5.1.H.264-NOGRP", "Heat.1995.PROPER.720p.WEB-DL.DD5.1.H.264-NOGRP", true},
+		// rejected candidate
+		{"Heat.1995.720p.WEB-DL.DD5.1.H.264-NOGRP", "Heat.1995.1080p.BluRay.x264-YIFY", false},
+		// quality not allowed in the profile
+		{"Heat.1995.720p.WEB-DL.DD5.1.H.264-NOGRP", "Heat.1995.2160p.UHD.BluRay.x264-NOGRP", false},
+	}
+
+	for _, test := range tests {
+		if upgrade, reason := evaluator.IsUpgrade(test.existing, test.candidate); upgrade != test.upgrade {
+			t.Errorf("%s -> %s: expected upgrade to be %v (%s)", test.existing, test.candidate, test.upgrade, reason)
+		}
+	}
+}
@@ -33,10 +33,10 @@ type GetMovieOptions struct {
 	FilterValue string
 	// FilterType can be 'equal'
 	FilterType string
-	// Page when pagination is on we can skip to a point in our results
-	Page string
-	// PageSize can be any number or '-1' to return everything without pagination
-	PageSize string
+	// Page when pagination is on we can skip to a point in our results -- defaults to 1
+	Page int
+	// PageSize can be any number or -1 to return everything without pagination -- defaults to -1
+	PageSize int
 	// SortKey can be 'sortTitle'
 	SortKey string
 	// SortDir can be 'asc' or 'desc'
@@ -70,11 +70,6 @@ func (c Client) Search(title string) ([]Movie, error) {
 	return results, nil
 }
 
-// SearchOffline searches for movies already in Radarr's library
-func (c Client) SearchOffline(title string) (Movie, error) {
-	return Movie{}, nil
-}
-
 // GetMovie returns a movie via the movie database id
 func (c Client) GetMovie(tmdbID int) (Movie, error) {
 	const endpoint = "/api/movie/lookup/tmdb"
@@ -107,15 +102,19 @@ func (c Client) GetMovie(tmdbID int) (Movie, error) {
 	return result, nil
 }
 
-// GetMovieIMDB returns a movie via the internet movie database id
-func (c Client) GetMovieIMDB(imdbID int) (Movie, error) {
+// GetMovieIMDB returns a movie via the internet movie database id, e.g. tt0133093
+func (c Client) GetMovieIMDB(imdbID string) (Movie, error) {
 	const endpoint = "/api/movie/lookup/imdb"
 
-	params := url.Values{}
+	var result Movie
 
-	params.Set("imdbId", strconv.Itoa(imdbID))
+	if !imdbIDRe.MatchString(imdbID) {
+		return result, errors.New("imdb id must look like tt0133093")
+	}
 
-	var result Movie
+	params := url.Values{}
+
+	params.Set("imdbId", imdbID)
 
 	resp, err := c.get(endpoint, params)
 
@@ -125,6 +124,10 @@ func (c Client) GetMovieIMDB(imdbID int) (Movie, error) {
 
 	defer resp.Body.Close()
 
+	if resp.StatusCode != http.StatusOK {
+		return result, errors.New(resp.Status)
+	}
+
 	err = json.NewDecoder(resp.Body).Decode(&result)
 
 	return result, err
@@ -132,34 +135,81 @@ func (c Client) GetMovieIMDB(imdbID int) (Movie, error) {
 
 // GetMovies returns all movies in radarr and in the wanted list
 func (c Client) GetMovies(options GetMovieOptions) ([]Movie, error) {
-	var movies []Movie
+	// return everything in results if not specified
+	if options.PageSize == 0 {
+		options.PageSize = -1
+	}
 
-	const endpoint = "/api/movie"
+	if options.PageSize == -1 {
+		var movies []Movie
 
-	params := url.Values{}
+		resp, err := c.get("/api/movie", movieParams(options))
 
-	if options.Page == "" {
-		options.Page = "1"
+		if err != nil {
+			return movies, err
+		}
+
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return movies, errors.New(resp.Status)
+		}
+
+		err = json.NewDecoder(resp.Body).Decode(&movies)
+
+		return movies, err
 	}
 
-	// return everything in results if not specified
-	if options.PageSize == "" {
-		options.Page = "-1"
+	library, err := c.GetLibrary(options)
+
+	return library.Records, err
+}
+
+// GetLibrary returns a page of the library along with the paging details.
+// A PageSize of -1 isn't supported here, use GetMovies to get everything
+func (c Client) GetLibrary(options GetMovieOptions) (Library, error) {
+	var library Library
+
+	if options.PageSize < 1 {
+		return library, errors.New("page size must be positive")
+	}
+
+	resp, err := c.get("/api/movie", movieParams(options))
+
+	if err != nil {
+		return library, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return library, errors.New(resp.Status)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&library)
+
+	return library, err
+}
+
+func movieParams(options GetMovieOptions) url.Values {
+	params := url.Values{}
+
+	if options.Page == 0 {
+		options.Page = 1
 	}
 
 	if options.SortKey == "" {
-		options.Page = "sortTitle"
+		options.SortKey = "sortTitle"
 	}
 
 	if options.SortDir == "" {
-		options.Page = "asc"
+		options.SortDir = "asc"
 	}
 
-	params.Set("page", options.Page)
-	params.Set("pageSize", options.PageSize)
+	params.Set("page", strconv.Itoa(options.Page))
+	params.Set("pageSize", strconv.Itoa(options.PageSize))
 	params.Set("sortKey", options.SortKey)
 	params.Set("sortDir", options.SortDir)
-	params.Set("filterType", options.FilterType)
 
 	if options.FilterKey != "" {
 		params.Set("filterKey", options.FilterKey)
@@ -173,31 +223,5 @@ func (c Client) GetMovies(options GetMovieOptions) ([]Movie, error) {
 		params.Set("filterType", options.FilterType)
 	}
 
-	resp, err := c.get(endpoint, params)
-
-	if err != nil {
-		return movies, err
-	}
-
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return movies, errors.New(resp.Status)
-	}
-
-	if options.PageSize == "-1" {
-		if err := json.NewDecoder(resp.Body).Decode(&movies); err != nil {
-			return movies, err
-		}
-	} else {
-		var library Library
-
-		if err := json.NewDecoder(resp.Body).Decode(&library); err != nil {
-			return movies, err
-		}
-
-		movies = library.Records
-	}
-
-	return movies, nil
+	return params
 }
@@ -1,8 +1,11 @@
 package radarr
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 )
 
@@ -34,6 +37,17 @@ type Profile struct {
 	Language      string `json:"language"`
 	Name          string `json:"name"`
 	PreferredTags string `json:"preferredTags"`
+	// FormatItems the score each custom format adds to a release
+	FormatItems []struct {
+		Format int    `json:"format"`
+		Name   string `json:"name"`
+		Score  int    `json:"score"`
+	} `json:"formatItems"`
+	// MinFormatScore releases scoring lower are rejected
+	MinFormatScore int `json:"minFormatScore"`
+	// CutoffFormatScore releases stop being upgraded once they reach this
+	// score and the cutoff quality
+	CutoffFormatScore int `json:"cutoffFormatScore"`
 }
 
 // GetRootFolders returns available root folders
@@ -79,3 +93,203 @@ func (c Client) GetProfiles() ([]Profile, error) {
 
 	return profiles, err
 }
+
+// AuthenticationMethod how radarr's web ui asks users to log in
+type AuthenticationMethod string
+
+const (
+	// AuthenticationNone no login
+	AuthenticationNone AuthenticationMethod = "none"
+	// AuthenticationBasic browser popup
+	AuthenticationBasic AuthenticationMethod = "basic"
+	// AuthenticationForms login page
+	AuthenticationForms AuthenticationMethod = "forms"
+)
+
+// ProxyType the kind of proxy radarr connects through
+type ProxyType string
+
+const (
+	// ProxyHTTP http(s) proxy
+	ProxyHTTP ProxyType = "http"
+	// ProxySocks4 socks4 proxy
+	ProxySocks4 ProxyType = "socks4"
+	// ProxySocks5 socks5 proxy
+	ProxySocks5 ProxyType = "socks5"
+)
+
+// HostConfig radarr's web server, security, proxy and logging settings
+type HostConfig struct {
+	ID                        int                  `json:"id"`
+	BindAddress               string               `json:"bindAddress"`
+	Port                      int                  `json:"port"`
+	SslPort                   int                  `json:"sslPort"`
+	EnableSsl                 bool                 `json:"enableSsl"`
+	LaunchBrowser             bool                 `json:"launchBrowser"`
+	AuthenticationMethod      AuthenticationMethod `json:"authenticationMethod"`
+	AnalyticsEnabled          bool                 `json:"analyticsEnabled"`
+	Username                  string               `json:"username"`
+	Password                  string               `json:"password"`
+	LogLevel                  string               `json:"logLevel"`
+	ConsoleLogLevel           string               `json:"consoleLogLevel"`
+	Branch                    string               `json:"branch"`
+	APIKey                    string               `json:"apiKey"`
+	SslCertPath               string               `json:"sslCertPath"`
+	SslCertPassword           string               `json:"sslCertPassword"`
+	URLBase                   string               `json:"urlBase"`
+	UpdateAutomatically       bool                 `json:"updateAutomatically"`
+	UpdateMechanism           string               `json:"updateMechanism"`
+	UpdateScriptPath          string               `json:"updateScriptPath"`
+	ProxyEnabled              bool                 `json:"proxyEnabled"`
+	ProxyType                 ProxyType            `json:"proxyType"`
+	ProxyHostname             string               `json:"proxyHostname"`
+	ProxyPort                 int                  `json:"proxyPort"`
+	ProxyUsername             string               `json:"proxyUsername"`
+	ProxyPassword             string               `json:"proxyPassword"`
+	ProxyBypassFilter         string               `json:"proxyBypassFilter"`
+	ProxyBypassLocalAddresses bool                 `json:"proxyBypassLocalAddresses"`
+	CertificateValidation     string               `json:"certificateValidation"`
+	BackupFolder              string               `json:"backupFolder"`
+	BackupInterval            int                  `json:"backupInterval"`
+	BackupRetention           int                  `json:"backupRetention"`
+
+	Unknown
+}
+
+// UnmarshalJSON decodes the host config and keeps the fields it doesn't model in Extra
+func (h *HostConfig) UnmarshalJSON(data []byte) error {
+	type plain HostConfig
+
+	return unmarshalUnknown(data, (*plain)(h), &h.Unknown)
+}
+
+// MarshalJSON encodes the host config with Extra merged back in
+func (h HostConfig) MarshalJSON() ([]byte, error) {
+	type plain HostConfig
+
+	return marshalUnknown(plain(h), h.Unknown)
+}
+
+// GetHostConfig returns radarr's host settings
+func (c Client) GetHostConfig() (HostConfig, error) {
+	const endpoint = "/api/config/host"
+	var config HostConfig
+
+	resp, err := c.get(endpoint, nil)
+
+	if err != nil {
+		return config, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return config, errors.New(resp.Status)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&config)
+
+	return config, err
+}
+
+// UpdateHostConfig saves radarr's host settings. Pass a config from
+// GetHostConfig with the changes applied so settings you don't touch keep
+// their values. Changing the port, url base or api key affects how this
+// client reaches radarr -- see RotateAPIKey for the latter
+func (c Client) UpdateHostConfig(config HostConfig) (HostConfig, error) {
+	const endpoint = "/api/config/host"
+
+	// check values radarr would reject
+	if config.Port < 1 || config.Port > 65535 {
+		return config, fmt.Errorf("port must be between 1 and 65535, got %d", config.Port)
+	}
+
+	if config.EnableSsl && (config.SslPort < 1 || config.SslPort > 65535) {
+		return config, fmt.Errorf("ssl port must be between 1 and 65535, got %d", config.SslPort)
+	}
+
+	switch config.AuthenticationMethod {
+	case AuthenticationNone:
+	case AuthenticationBasic, AuthenticationForms:
+		if config.Username == "" || config.Password == "" {
+			return config, errors.New("username and password are required when authentication is enabled")
+		}
+	default:
+		return config, fmt.Errorf("unknown authentication method: %q", config.AuthenticationMethod)
+	}
+
+	if config.ProxyEnabled {
+		switch config.ProxyType {
+		case ProxyHTTP, ProxySocks4, ProxySocks5:
+		default:
+			return config, fmt.Errorf("unknown proxy type: %q", config.ProxyType)
+		}
+
+		if config.ProxyHostname == "" {
+			return config, errors.New("proxy hostname is required when the proxy is enabled")
+		}
+
+		if config.ProxyPort < 1 || config.ProxyPort > 65535 {
+			return config, fmt.Errorf("proxy port must be between 1 and 65535, got %d", config.ProxyPort)
+		}
+	}
+
+	if config.APIKey == "" {
+		return config, errors.New("api key is required")
+	}
+
+	requestPayload, err := json.Marshal(config)
+
+	if err != nil {
+		return config, err
+	}
+
+	resp, err := c.put(endpoint, requestPayload)
+
+	if err != nil {
+		return config, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusAccepted && resp.StatusCode != http.StatusOK {
+		return config, errors.New(resp.Status)
+	}
+
+	var updated HostConfig
+
+	err = json.NewDecoder(resp.Body).Decode(&updated)
+
+	return updated, err
+}
+
+// RotateAPIKey replaces radarr's api key with a newly generated one and
+// switches the client over to it once radarr has accepted the change
+func (c *Client) RotateAPIKey() (string, error) {
+	config, err := c.GetHostConfig()
+
+	if err != nil {
+		return "", err
+	}
+
+	key := make([]byte, 16)
+
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+
+	config.APIKey = hex.EncodeToString(key)
+
+	if _, err := c.UpdateHostConfig(config); err != nil {
+		return "", err
+	}
+
+	c.APIKey = config.APIKey
+
+	// make sure radarr honors the new key
+	if _, err := c.GetSystemStatus(); err != nil {
+		return config.APIKey, fmt.Errorf("radarr accepted the new api key but rejected a request using it: %v", err)
+	}
+
+	return config.APIKey, nil
+}
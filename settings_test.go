@@ -0,0 +1,58 @@
+package radarr
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRotateAPIKey(t *testing.T) {
+	config := HostConfig{ID: 1, Port: 7878, AuthenticationMethod: AuthenticationNone, APIKey: "abc123"}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("x-api-key") != config.APIKey {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/api/config/host":
+			if r.Method == "PUT" {
+				json.NewDecoder(r.Body).Decode(&config)
+				w.WriteHeader(http.StatusAccepted)
+			}
+
+			json.NewEncoder(w).Encode(config)
+		case "/api/system/status":
+			w.Write([]byte(`{"version":"0.2.0.1358"}`))
+		}
+	}))
+	defer server.Close()
+
+	client, err := New(server.URL, "abc123")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key, err := client.RotateAPIKey()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if key == "abc123" || len(key) != 32 {
+		t.Errorf("expected a new 32 character key, got %q", key)
+	}
+
+	if client.APIKey != key || config.APIKey != key {
+		t.Errorf("expected both the client and radarr to use %q, got %q and %q", key, client.APIKey, config.APIKey)
+	}
+
+	config.Port = 0
+
+	if _, err := client.UpdateHostConfig(config); err == nil {
+		t.Error("expected an invalid port to be rejected")
+	}
+}
@@ -0,0 +1,192 @@
+package radarr
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// HealthType is the severity radarr assigns to a health check
+type HealthType string
+
+const (
+	// HealthOK nothing is wrong
+	HealthOK HealthType = "ok"
+	// HealthNotice informational, e.g. an update is available
+	HealthNotice HealthType = "notice"
+	// HealthWarning something needs attention, e.g. an indexer is unavailable
+	HealthWarning HealthType = "warning"
+	// HealthError radarr can't function properly, e.g. a root folder is missing
+	HealthError HealthType = "error"
+)
+
+// Level ranks a severity so they can be compared -- unknown types rank lowest
+func (h HealthType) Level() int {
+	switch h {
+	case HealthNotice:
+		return 1
+	case HealthWarning:
+		return 2
+	case HealthError:
+		return 3
+	}
+
+	return 0
+}
+
+// Health is a single issue reported by radarr's health checks
+type Health struct {
+	Source  string     `json:"source"`
+	Type    HealthType `json:"type"`
+	Message string     `json:"message"`
+	WikiURL string     `json:"wikiUrl"`
+}
+
+// DiskSpace free and total space of a mount radarr can see
+type DiskSpace struct {
+	Path       string `json:"path"`
+	Label      string `json:"label"`
+	FreeSpace  int64  `json:"freeSpace"`
+	TotalSpace int64  `json:"totalSpace"`
+}
+
+// SystemStatus information about the radarr instance
+type SystemStatus struct {
+	Version           string `json:"version"`
+	BuildTime         string `json:"buildTime"`
+	IsDebug           bool   `json:"isDebug"`
+	IsProduction      bool   `json:"isProduction"`
+	IsAdmin           bool   `json:"isAdmin"`
+	IsUserInteractive bool   `json:"isUserInteractive"`
+	StartupPath       string `json:"startupPath"`
+	AppData           string `json:"appData"`
+	OsName            string `json:"osName"`
+	OsVersion         string `json:"osVersion"`
+	IsMonoRuntime     bool   `json:"isMonoRuntime"`
+	IsMono            bool   `json:"isMono"`
+	IsLinux           bool   `json:"isLinux"`
+	IsOsx             bool   `json:"isOsx"`
+	IsWindows         bool   `json:"isWindows"`
+	Branch            string `json:"branch"`
+	Authentication    string `json:"authentication"`
+	SqliteVersion     string `json:"sqliteVersion"`
+	URLBase           string `json:"urlBase"`
+	RuntimeVersion    string `json:"runtimeVersion"`
+	RuntimeName       string `json:"runtimeName"`
+}
+
+// ErrorUnhealthy radarr reported at least one health check of error severity
+var ErrorUnhealthy = errors.New("radarr reported an unhealthy status")
+
+// GetHealth returns the issues found by radarr's health checks
+func (c Client) GetHealth() ([]Health, error) {
+	return c.getHealth(context.Background())
+}
+
+func (c Client) getHealth(ctx context.Context) ([]Health, error) {
+	const endpoint = "/api/health"
+	var health []Health
+
+	resp, err := c.getWithContext(ctx, endpoint, nil)
+
+	if err != nil {
+		return health, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return health, errors.New(resp.Status)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&health)
+
+	return health, err
+}
+
+// GetDiskSpace returns free and total space for each mount radarr can see
+func (c Client) GetDiskSpace() ([]DiskSpace, error) {
+	const endpoint = "/api/diskspace"
+	var disks []DiskSpace
+
+	resp, err := c.get(endpoint, nil)
+
+	if err != nil {
+		return disks, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return disks, errors.New(resp.Status)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&disks)
+
+	return disks, err
+}
+
+// GetSystemStatus returns version and environment info of the radarr instance
+func (c Client) GetSystemStatus() (SystemStatus, error) {
+	return c.getSystemStatus(context.Background())
+}
+
+func (c Client) getSystemStatus(ctx context.Context) (SystemStatus, error) {
+	const endpoint = "/api/system/status"
+	var status SystemStatus
+
+	resp, err := c.getWithContext(ctx, endpoint, nil)
+
+	if err != nil {
+		return status, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return status, errors.New(resp.Status)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&status)
+
+	return status, err
+}
+
+// Ping checks that radarr is reachable and accepts our api key
+func (c Client) Ping(ctx context.Context) error {
+	_, err := c.getSystemStatus(ctx)
+
+	return err
+}
+
+// Healthy is a readiness check -- radarr must be reachable and report no
+// health checks of error severity. The returned error wraps ErrorUnhealthy
+// along with the offending messages when radarr is up but unhealthy
+func (c Client) Healthy(ctx context.Context) error {
+	if err := c.Ping(ctx); err != nil {
+		return err
+	}
+
+	health, err := c.getHealth(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	var messages []string
+
+	for _, check := range health {
+		if check.Type.Level() >= HealthError.Level() {
+			messages = append(messages, check.Message)
+		}
+	}
+
+	if len(messages) > 0 {
+		return fmt.Errorf("%w: %s", ErrorUnhealthy, strings.Join(messages, "; "))
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package radarr
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHealthy(t *testing.T) {
+	health := `[{"source":"IndexerStatusCheck","type":"warning","message":"Indexers unavailable"}]`
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/api/system/status", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("x-api-key") != "abc123" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		w.Write([]byte(`{"version":"0.2.0.1358"}`))
+	})
+
+	mux.HandleFunc("/api/health", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(health))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := New(server.URL, "abc123")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.Healthy(context.Background()); err != nil {
+		t.Errorf("expected warnings to be healthy, got %v", err)
+	}
+
+	health = `[{"source":"RootFolderCheck","type":"error","message":"Missing root folder: /movies"}]`
+
+	if err := client.Healthy(context.Background()); !errors.Is(err, ErrorUnhealthy) {
+		t.Errorf("expected ErrorUnhealthy, got %v", err)
+	}
+
+	client.APIKey = "wrong"
+
+	if err := client.Ping(context.Background()); err == nil {
+		t.Error("expected ping with a bad api key to fail")
+	}
+}
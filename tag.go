@@ -0,0 +1,68 @@
+package radarr
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+// Tag a label radarr uses to scope profiles and restrictions to movies
+type Tag struct {
+	ID    int    `json:"id"`
+	Label string `json:"label"`
+}
+
+// GetTags returns every tag
+func (c Client) GetTags() ([]Tag, error) {
+	const endpoint = "/api/tag"
+	var tags []Tag
+
+	resp, err := c.get(endpoint, nil)
+
+	if err != nil {
+		return tags, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return tags, errors.New(resp.Status)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&tags)
+
+	return tags, err
+}
+
+// CreateTag adds a tag and returns it with its new id
+func (c Client) CreateTag(label string) (Tag, error) {
+	const endpoint = "/api/tag"
+
+	tag := Tag{Label: label}
+
+	if label == "" {
+		return tag, errors.New("label is required")
+	}
+
+	requestPayload, err := json.Marshal(tag)
+
+	if err != nil {
+		return tag, err
+	}
+
+	resp, err := c.post(endpoint, requestPayload)
+
+	if err != nil {
+		return tag, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return tag, errors.New(resp.Status)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&tag)
+
+	return tag, err
+}
@@ -2,15 +2,24 @@ package radarr
 
 import (
 	"bytes"
+	"context"
+	"io"
+	"mime/multipart"
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 )
 
 // utils.go holds network utils and function helpers
 
 func (c Client) get(query string, params url.Values) (*http.Response, error) {
+	return c.getWithContext(context.Background(), query, params)
+}
+
+// getWithContext is get but the request is bound to ctx
+func (c Client) getWithContext(ctx context.Context, query string, params url.Values) (*http.Response, error) {
 	endpointURL, err := url.Parse(query)
 
 	if err != nil {
@@ -29,7 +38,7 @@ func (c Client) get(query string, params url.Values) (*http.Response, error) {
 
 	requestURL := appendEndpoint(c.URL.String(), endpointURL.String())
 
-	req, err := http.NewRequest("GET", requestURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
 
 	if err != nil {
 		return &http.Response{}, err
@@ -66,6 +75,78 @@ func (c Client) post(query string, body []byte) (*http.Response, error) {
 	return client.Do(req)
 }
 
+func (c Client) put(query string, body []byte) (*http.Response, error) {
+	endpointURL, err := url.Parse(query)
+
+	if err != nil {
+		return &http.Response{}, err
+	}
+
+	client := http.Client{
+		Timeout: time.Duration(c.Timeout) * time.Second,
+	}
+
+	requestURL := appendEndpoint(c.URL.String(), endpointURL.String())
+
+	req, err := http.NewRequest("PUT", requestURL, bytes.NewBuffer(body))
+
+	if err != nil {
+		return &http.Response{}, err
+	}
+
+	req.Header.Set("x-api-key", c.APIKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	return client.Do(req)
+}
+
+// upload posts r as a multipart file under field. The body is streamed so
+// large files, e.g. backup archives, aren't held in memory
+func (c Client) upload(query, field, fileName string, r io.Reader) (*http.Response, error) {
+	endpointURL, err := url.Parse(query)
+
+	if err != nil {
+		return &http.Response{}, err
+	}
+
+	body, pipe := io.Pipe()
+	writer := multipart.NewWriter(pipe)
+
+	go func() {
+		part, err := writer.CreateFormFile(field, fileName)
+
+		if err == nil {
+			_, err = io.Copy(part, r)
+		}
+
+		if err == nil {
+			err = writer.Close()
+		}
+
+		// a nil error closes the pipe normally
+		pipe.CloseWithError(err)
+	}()
+
+	client := http.Client{
+		Timeout: time.Duration(c.Timeout) * time.Second,
+	}
+
+	requestURL := appendEndpoint(c.URL.String(), endpointURL.String())
+
+	req, err := http.NewRequest("POST", requestURL, body)
+
+	if err != nil {
+		body.CloseWithError(err)
+		return &http.Response{}, err
+	}
+
+	req.Header.Set("x-api-key", c.APIKey)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	// the transport closes body, even on errors, which unblocks the writer
+	return client.Do(req)
+}
+
 func (c Client) delete(query string, params url.Values) (*http.Response, error) {
 	endpointURL, err := url.Parse(query)
 
@@ -97,6 +178,71 @@ func (c Client) delete(query string, params url.Values) (*http.Response, error)
 	return client.Do(req)
 }
 
+// deleteWithBody is delete for endpoints that take a json payload, e.g. bulk removals
+func (c Client) deleteWithBody(query string, body []byte) (*http.Response, error) {
+	endpointURL, err := url.Parse(query)
+
+	if err != nil {
+		return &http.Response{}, err
+	}
+
+	client := http.Client{
+		Timeout: time.Duration(c.Timeout) * time.Second,
+	}
+
+	requestURL := appendEndpoint(c.URL.String(), endpointURL.String())
+
+	req, err := http.NewRequest("DELETE", requestURL, bytes.NewBuffer(body))
+
+	if err != nil {
+		return &http.Response{}, err
+	}
+
+	req.Header.Set("x-api-key", c.APIKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	return client.Do(req)
+}
+
+// movieWorkers how many movies eachMovie handles at once
+const movieWorkers = 4
+
+// eachMovie calls fn for every movie, a few at a time, for helpers that make
+// a request per library movie. It returns the first error in library order
+func eachMovie(movies []Movie, fn func(i int, movie Movie) error) error {
+	errs := make([]error, len(movies))
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+
+	for w := 0; w < movieWorkers; w++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range indexes {
+				errs[i] = fn(i, movies[i])
+			}
+		}()
+	}
+
+	for i := range movies {
+		indexes <- i
+	}
+
+	close(indexes)
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func encodeURL(str string) (string, error) {
 	u, err := url.Parse(str)
 
@@ -5,86 +5,94 @@ import (
 	"errors"
 	"net/http"
 	"net/url"
+	"strconv"
 )
 
-// Wanted ...
+// Wanted a page of movies radarr is still looking for
 type Wanted struct {
-	Page     int `json:"page"`
-	PageSize int `json:"pageSize"`
-	Records  []struct {
-		Added             string `json:"added"`
-		AlternativeTitles []struct {
-			ID         int    `json:"id"`
-			Language   string `json:"language"`
-			MovieID    int    `json:"movieId"`
-			SourceID   int    `json:"sourceId"`
-			SourceType string `json:"sourceType"`
-			Title      string `json:"title"`
-			VoteCount  int    `json:"voteCount"`
-			Votes      int    `json:"votes"`
-		} `json:"alternativeTitles"`
-		CleanTitle string        `json:"cleanTitle"`
-		Downloaded bool          `json:"downloaded"`
-		FolderName string        `json:"folderName"`
-		Genres     []interface{} `json:"genres"`
-		HasFile    bool          `json:"hasFile"`
-		ID         int           `json:"id"`
-		Images     []struct {
-			CoverType string `json:"coverType"`
-			URL       string `json:"url"`
-		} `json:"images"`
-		ImdbID              string `json:"imdbId"`
-		InCinemas           string `json:"inCinemas"`
-		IsAvailable         bool   `json:"isAvailable"`
-		LastInfoSync        string `json:"lastInfoSync"`
-		MinimumAvailability string `json:"minimumAvailability"`
-		Monitored           bool   `json:"monitored"`
-		Overview            string `json:"overview"`
-		Path                string `json:"path"`
-		PathState           string `json:"pathState"`
-		PhysicalRelease     string `json:"physicalRelease"`
-		ProfileID           int    `json:"profileId"`
-		QualityProfileID    int    `json:"qualityProfileId"`
-		Ratings             struct {
-			Value float64 `json:"value"`
-			Votes int     `json:"votes"`
-		} `json:"ratings"`
-		Runtime               int           `json:"runtime"`
-		SecondaryYearSourceID int           `json:"secondaryYearSourceId"`
-		SizeOnDisk            int           `json:"sizeOnDisk"`
-		SortTitle             string        `json:"sortTitle"`
-		Status                string        `json:"status"`
-		Studio                string        `json:"studio"`
-		Tags                  []interface{} `json:"tags"`
-		Title                 string        `json:"title"`
-		TitleSlug             string        `json:"titleSlug"`
-		TmdbID                int           `json:"tmdbId"`
-		Website               string        `json:"website"`
-		Year                  int           `json:"year"`
-		YouTubeTrailerID      string        `json:"youTubeTrailerId"`
-	} `json:"records"`
-	SortDirection string `json:"sortDirection"`
-	SortKey       string `json:"sortKey"`
-	TotalRecords  int    `json:"totalRecords"`
+	Page          int     `json:"page"`
+	PageSize      int     `json:"pageSize"`
+	Records       []Movie `json:"records"`
+	SortDirection string  `json:"sortDirection"`
+	SortKey       string  `json:"sortKey"`
+	TotalRecords  int     `json:"totalRecords"`
 }
 
-// GetWantedMissing returns a filtered wanted list of missing media
-func (c Client) GetWantedMissing() (Wanted, error) {
-	const endpoint = "/api/wanted/missing"
+// MonitoredFilter which movies the wanted lists return by monitored state
+type MonitoredFilter int
 
-	params := make(url.Values, 1)
+const (
+	// MonitoredOnly only monitored movies, like radarr's ui
+	MonitoredOnly MonitoredFilter = iota
+	// UnmonitoredOnly only unmonitored movies
+	UnmonitoredOnly
+	// MonitoredAny movies regardless of their monitored state
+	MonitoredAny
+)
 
-	params.Set("page", "1")
-	params.Set("pageSize", "50")
-	params.Set("sortKey", "title")
-	params.Set("sortDir", "asc")
-	params.Set("filterKey", "monitored")
-	params.Set("filterValue", "true")
-	params.Set("filterType", "equal")
+// WantedOptions change the params when using GetWantedMissing and GetWantedCutoff
+type WantedOptions struct {
+	// Page defaults to 1
+	Page int
+	// PageSize defaults to 50
+	PageSize int
+	// SortKey can be 'title', 'year', 'inCinemas' or 'physicalRelease' -- defaults to 'title'
+	SortKey string
+	// SortDir can be 'asc' or 'desc' -- defaults to 'asc'
+	SortDir string
+	// Monitored defaults to MonitoredOnly
+	Monitored MonitoredFilter
+}
 
+// GetWantedMissing returns a page of monitored movies without a file
+func (c Client) GetWantedMissing(options WantedOptions) (Wanted, error) {
+	return c.getWanted("/api/wanted/missing", options)
+}
+
+// GetWantedCutoff returns a page of movies whose file hasn't met the
+// quality profile's cutoff yet
+func (c Client) GetWantedCutoff(options WantedOptions) (Wanted, error) {
+	return c.getWanted("/api/wanted/cutoff", options)
+}
+
+func (c Client) getWanted(endpoint string, options WantedOptions) (Wanted, error) {
 	var wanted Wanted
 
-	resp, err := c.get(endpoint, nil)
+	if options.Page == 0 {
+		options.Page = 1
+	}
+
+	if options.PageSize == 0 {
+		options.PageSize = 50
+	}
+
+	if options.SortKey == "" {
+		options.SortKey = "title"
+	}
+
+	if options.SortDir == "" {
+		options.SortDir = "asc"
+	}
+
+	params := url.Values{}
+
+	params.Set("page", strconv.Itoa(options.Page))
+	params.Set("pageSize", strconv.Itoa(options.PageSize))
+	params.Set("sortKey", options.SortKey)
+	params.Set("sortDir", options.SortDir)
+
+	switch options.Monitored {
+	case MonitoredOnly:
+		params.Set("filterKey", "monitored")
+		params.Set("filterValue", "true")
+		params.Set("filterType", "equal")
+	case UnmonitoredOnly:
+		params.Set("filterKey", "monitored")
+		params.Set("filterValue", "false")
+		params.Set("filterType", "equal")
+	}
+
+	resp, err := c.get(endpoint, params)
 
 	if err != nil {
 		return wanted, err
@@ -93,7 +101,7 @@ func (c Client) GetWantedMissing() (Wanted, error) {
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return wanted, errors.New(err.Error())
+		return wanted, errors.New(resp.Status)
 	}
 
 	err = json.NewDecoder(resp.Body).Decode(&wanted)
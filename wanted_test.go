@@ -0,0 +1,55 @@
+package radarr
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetWanted(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+
+		if r.URL.Path == "/api/wanted/cutoff" && query.Get("filterValue") != "" {
+			t.Errorf("expected MonitoredAny to skip the monitored filter, got %s", r.URL.RawQuery)
+		}
+
+		if r.URL.Path == "/api/wanted/missing" {
+			if query.Get("page") != "2" || query.Get("pageSize") != "50" || query.Get("filterValue") != "true" {
+				t.Errorf("expected page 2 of monitored movies, got %s", r.URL.RawQuery)
+			}
+		}
+
+		if query.Get("page") == "3" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		w.Write([]byte(`{"page":2,"pageSize":50,"totalRecords":51,"records":[{"id":1,"title":"Heat","year":1995,"monitored":true}]}`))
+	}))
+	defer server.Close()
+
+	client, err := New(server.URL, "abc123")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wanted, err := client.GetWantedMissing(WantedOptions{Page: 2})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(wanted.Records) != 1 || wanted.Records[0].Title != "Heat" || wanted.TotalRecords != 51 {
+		t.Errorf("unexpected wanted page: %+v", wanted)
+	}
+
+	if _, err := client.GetWantedCutoff(WantedOptions{Monitored: MonitoredAny}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.GetWantedCutoff(WantedOptions{Page: 3, Monitored: MonitoredAny}); err == nil {
+		t.Error("expected a non-200 status to return an error")
+	}
+}